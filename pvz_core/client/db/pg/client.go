@@ -4,7 +4,7 @@ import (
 	"context"
 	"time"
 
-	db "github.com/MaksimovDenis/pvz_grpc/internal/client"
+	db "github.com/MaksimovDenis/pvz_core/client"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
@@ -38,6 +38,10 @@ func New(ctx context.Context, dsn string) (db.Client, error) {
 		return nil, errors.Errorf("failed to connect db after %d attempts: %v", maxRetries, err)
 	}
 
+	if err := migration(dsn); err != nil {
+		return nil, errors.Errorf("failed to init migrations: %v", err)
+	}
+
 	return &pgClient{
 		masterDBC: &pg{dbc: dbc},
 	}, nil
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_pvz_created_at_id ON pvz(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_pvz_city_created_at_id ON pvz(city, created_at, id);
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_receptions_pvz_id_created_at ON receptions(pvz_id, created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS cities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) UNIQUE NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    launch_date TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO cities (name)
+VALUES ('Москва'), ('Санкт-Петербург'), ('Казань')
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_city_check;
+ALTER TABLE pvz ADD CONSTRAINT fk_pvz_city FOREIGN KEY (city) REFERENCES cities(name) ON UPDATE CASCADE;
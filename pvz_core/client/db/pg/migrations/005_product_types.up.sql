@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS product_types (
+    code VARCHAR(255) PRIMARY KEY,
+    display_name TEXT NOT NULL,
+    fragile BOOLEAN NOT NULL DEFAULT FALSE,
+    oversized BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO product_types (code, display_name, fragile)
+VALUES
+    ('электроника', 'Электроника', TRUE),
+    ('одежда', 'Одежда', FALSE),
+    ('обувь', 'Обувь', FALSE)
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_product_type_check;
+ALTER TABLE products ADD CONSTRAINT fk_product_type FOREIGN KEY (product_type) REFERENCES product_types(code) ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS status VARCHAR(255) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'suspended', 'closed'));
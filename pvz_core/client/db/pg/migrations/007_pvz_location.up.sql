@@ -0,0 +1,5 @@
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS address TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS lat DOUBLE PRECISION CHECK (lat BETWEEN -90 AND 90);
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS lon DOUBLE PRECISION CHECK (lon BETWEEN -180 AND 180);
+
+CREATE INDEX IF NOT EXISTS idx_pvz_lat_lon ON pvz(lat, lon);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS pvz_schedule (
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    open_time TIME NOT NULL,
+    close_time TIME NOT NULL,
+
+    PRIMARY KEY (pvz_id, weekday),
+    CHECK (open_time < close_time)
+);
+
+CREATE TABLE IF NOT EXISTS pvz_schedule_exceptions (
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    is_closed BOOLEAN NOT NULL DEFAULT FALSE,
+    open_time TIME,
+    close_time TIME,
+
+    PRIMARY KEY (pvz_id, date),
+    CHECK (is_closed OR (open_time IS NOT NULL AND close_time IS NOT NULL AND open_time < close_time))
+);
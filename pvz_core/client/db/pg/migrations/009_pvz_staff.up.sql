@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS pvz_staff (
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (pvz_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pvz_staff_user_id ON pvz_staff(user_id);
@@ -0,0 +1,68 @@
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(255) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    city_scoped BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    code VARCHAR(255) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(255) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission VARCHAR(255) NOT NULL REFERENCES permissions(code) ON DELETE CASCADE,
+
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO roles (name, description, city_scoped)
+VALUES
+    ('moderator', 'Модератор: управление ПВЗ, городами и справочниками', FALSE),
+    ('employee', 'Сотрудник ПВЗ: приёмка и товары', FALSE),
+    ('auditor', 'Аудитор: только чтение', FALSE),
+    ('regional_manager', 'Региональный менеджер: управление ПВЗ своего города', TRUE)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (code, description)
+VALUES
+    ('pvz:read', 'Просмотр ПВЗ, графиков работы и справочников'),
+    ('pvz:create', 'Создание ПВЗ'),
+    ('pvz:update', 'Изменение и деактивация ПВЗ'),
+    ('pvz:delete', 'Удаление ПВЗ'),
+    ('schedule:manage', 'Изменение графика работы ПВЗ'),
+    ('staff:manage', 'Закрепление сотрудников за ПВЗ'),
+    ('reception:create', 'Создание приёмки'),
+    ('reception:close', 'Закрытие приёмки'),
+    ('product:create', 'Добавление товара в приёмку'),
+    ('product:delete', 'Удаление товара из приёмки'),
+    ('city:manage', 'Управление городами'),
+    ('product_type:manage', 'Управление типами товаров')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+VALUES
+    ('moderator', 'pvz:read'),
+    ('moderator', 'pvz:create'),
+    ('moderator', 'pvz:update'),
+    ('moderator', 'pvz:delete'),
+    ('moderator', 'schedule:manage'),
+    ('moderator', 'staff:manage'),
+    ('moderator', 'city:manage'),
+    ('moderator', 'product_type:manage'),
+    ('employee', 'pvz:read'),
+    ('employee', 'reception:create'),
+    ('employee', 'reception:close'),
+    ('employee', 'product:create'),
+    ('employee', 'product:delete'),
+    ('auditor', 'pvz:read'),
+    ('regional_manager', 'pvz:read'),
+    ('regional_manager', 'pvz:update'),
+    ('regional_manager', 'schedule:manage'),
+    ('regional_manager', 'staff:manage')
+ON CONFLICT (role, permission) DO NOTHING;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT fk_users_role FOREIGN KEY (role) REFERENCES roles(name) ON UPDATE CASCADE;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS city VARCHAR(255) REFERENCES cities(name) ON UPDATE CASCADE;
@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users(created_at, id) WHERE deleted_at IS NULL;
+
+INSERT INTO permissions (code, description)
+VALUES ('user:manage', 'Управление пользователями')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+VALUES ('moderator', 'user:manage')
+ON CONFLICT (role, permission) DO NOTHING;
@@ -0,0 +1,28 @@
+INSERT INTO roles (name, description, city_scoped)
+VALUES ('integration', 'Интеграция: служебный пользователь API ключа, права задаются ключом', FALSE)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (code, description)
+VALUES ('api_key:manage', 'Управление API ключами интеграций')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+VALUES ('moderator', 'api_key:manage')
+ON CONFLICT (role, permission) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    key_prefix VARCHAR(32) NOT NULL,
+    key_hash TEXT UNIQUE NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id),
+    permissions TEXT[] NOT NULL,
+    pvz_id UUID REFERENCES pvz(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
@@ -0,0 +1,3 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS closed_by UUID REFERENCES users(id);
+
+CREATE INDEX IF NOT EXISTS idx_products_reception_id_created_at ON products(reception_id, created_at, id);
@@ -0,0 +1,2 @@
+-- Access токены, выданные раньше этой отметки, больше не принимаются
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tokens_valid_after TIMESTAMPTZ;
@@ -3,8 +3,8 @@ package pg
 import (
 	"context"
 
-	db "github.com/MaksimovDenis/pvz_grpc/internal/client"
-	"github.com/MaksimovDenis/pvz_grpc/internal/client/db/pg/prettier"
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/client/db/pg/prettier"
 
 	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgconn"
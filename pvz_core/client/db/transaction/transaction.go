@@ -3,8 +3,8 @@ package transaction
 import (
 	"context"
 
-	db "github.com/MaksimovDenis/avito_pvz/internal/client"
-	"github.com/MaksimovDenis/avito_pvz/internal/client/db/pg"
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/client/db/pg"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
@@ -21,12 +21,17 @@ func NewTransactionsManager(db db.Transactor) db.TxManager {
 }
 
 func (mgr *manager) transaction(ctx context.Context, opts pgx.TxOptions, fnc db.Handler) (err error) {
-	tx, ok := ctx.Value(pg.TxKey).(pgx.Tx)
+	_, ok := ctx.Value(pg.TxKey).(pgx.Tx)
 	if ok {
 		return fnc(ctx)
 	}
 
-	tx, err = mgr.db.BeginTx(ctx, opts)
+	tx, err := mgr.db.BeginTx(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "can't begin transaction")
+	}
+
+	ctx = pg.MakeContextTx(ctx, tx)
 
 	defer func() {
 		if rec := recover(); rec != nil {
@@ -41,11 +46,9 @@ func (mgr *manager) transaction(ctx context.Context, opts pgx.TxOptions, fnc db.
 			return
 		}
 
-		if nil == err {
-			err = tx.Commit(ctx)
-			if err != nil {
-				err = errors.Wrapf(err, "tx commit failed")
-			}
+		err = tx.Commit(ctx)
+		if err != nil {
+			err = errors.Wrapf(err, "tx commit failed")
 		}
 	}()
 
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	appEnvEnvName = "APP_ENV"
+
+	EnvDev     = "dev"
+	EnvStaging = "staging"
+	EnvProd    = "prod"
+)
+
+type AppConfig interface {
+	Env() string
+	DummyLoginEnabled() bool
+}
+
+type appConfig struct {
+	env string
+}
+
+// NewAppConfig reads the environment the service runs in. Without APP_ENV the
+// service assumes prod, so a forgotten variable does not open dummy login.
+func NewAppConfig() (AppConfig, error) {
+	env := os.Getenv(appEnvEnvName)
+	if len(env) == 0 {
+		env = EnvProd
+	}
+
+	if env != EnvDev && env != EnvStaging && env != EnvProd {
+		return nil, errors.Errorf("unknown app env %q, expected %s, %s or %s", env, EnvDev, EnvStaging, EnvProd)
+	}
+
+	return &appConfig{
+		env: env,
+	}, nil
+}
+
+func (cfg *appConfig) Env() string {
+	return cfg.env
+}
+
+// DummyLoginEnabled reports whether /dummyLogin and the seeded dummy users
+// are available. They are meant for development and testing only.
+func (cfg *appConfig) DummyLoginEnabled() bool {
+	return cfg.env != EnvProd
+}
@@ -8,17 +8,20 @@ import (
 )
 
 const (
-	grpcHostEnvName = "GRPC_HOST"
-	grpcPortEnvName = "GRPC_PORT"
+	grpcHostEnvName        = "GRPC_HOST"
+	grpcPortEnvName        = "GRPC_PORT"
+	grpcMetricsPortEnvName = "GRPC_METRICS_PORT"
 )
 
 type GRPCConfig interface {
 	Address() string
+	MetricsAddress() string
 }
 
 type grpcConfig struct {
-	host string
-	port string
+	host        string
+	port        string
+	metricsPort string
 }
 
 func NewGRPCConfig() (GRPCConfig, error) {
@@ -33,11 +36,21 @@ func NewGRPCConfig() (GRPCConfig, error) {
 	}
 
 	return &grpcConfig{
-		host: host,
-		port: port,
+		host:        host,
+		port:        port,
+		metricsPort: os.Getenv(grpcMetricsPortEnvName),
 	}, nil
 }
 
 func (cfg *grpcConfig) Address() string {
 	return net.JoinHostPort(cfg.host, cfg.port)
 }
+
+// MetricsAddress is empty when the Prometheus endpoint is disabled.
+func (cfg *grpcConfig) MetricsAddress() string {
+	if cfg.metricsPort == "" {
+		return ""
+	}
+
+	return net.JoinHostPort(cfg.host, cfg.metricsPort)
+}
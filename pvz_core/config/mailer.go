@@ -0,0 +1,25 @@
+package config
+
+import "os"
+
+const mailerFileEnvName = "MAILER_FILE"
+
+type MailerConfig interface {
+	File() string
+}
+
+type mailerConfig struct {
+	file string
+}
+
+// NewMailerConfig reads where outgoing emails are written. There is no SMTP
+// sender yet: emails go to MAILER_FILE or, when it is not set, to the log.
+func NewMailerConfig() (MailerConfig, error) {
+	return &mailerConfig{
+		file: os.Getenv(mailerFileEnvName),
+	}, nil
+}
+
+func (cfg *mailerConfig) File() string {
+	return cfg.file
+}
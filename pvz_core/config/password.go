@@ -0,0 +1,159 @@
+package config
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	passwordMinLengthEnvName  = "PASSWORD_MIN_LENGTH"
+	passwordClassesEnvName    = "PASSWORD_REQUIRED_CLASSES"
+	passwordDenylistEnvName   = "PASSWORD_DENYLIST_FILE"
+	passwordBcryptCostEnvName = "PASSWORD_BCRYPT_COST"
+	passwordResetTTLEnvName   = "PASSWORD_RESET_TTL"
+	passwordResetURLEnvName   = "PASSWORD_RESET_URL"
+
+	defaultPasswordMinLength = 8
+	defaultPasswordClasses   = "lower,upper,digit"
+	defaultPasswordResetTTL  = time.Hour
+
+	// bcrypt учитывает только первые 72 байта пароля
+	maxPasswordLength = 72
+)
+
+var passwordClasses = map[string]bool{
+	"lower":   true,
+	"upper":   true,
+	"digit":   true,
+	"special": true,
+}
+
+type PasswordConfig interface {
+	MinLength() int
+	RequiredClasses() []string
+	Denylist() []string
+	BcryptCost() int
+	ResetTTL() time.Duration
+	ResetURL() string
+}
+
+type passwordConfig struct {
+	minLength       int
+	requiredClasses []string
+	denylist        []string
+	bcryptCost      int
+	resetTTL        time.Duration
+	resetURL        string
+}
+
+// NewPasswordConfig reads the password policy and loads the denylist of
+// breached passwords, one password per line, from PASSWORD_DENYLIST_FILE.
+func NewPasswordConfig() (PasswordConfig, error) {
+	var err error
+
+	cfg := &passwordConfig{
+		minLength:  defaultPasswordMinLength,
+		bcryptCost: bcrypt.DefaultCost,
+		resetTTL:   defaultPasswordResetTTL,
+		resetURL:   os.Getenv(passwordResetURLEnvName),
+	}
+
+	if value := os.Getenv(passwordMinLengthEnvName); len(value) != 0 {
+		cfg.minLength, err = strconv.Atoi(value)
+		if err != nil || cfg.minLength < 1 || cfg.minLength > maxPasswordLength {
+			return nil, errors.Errorf("password min length must be between 1 and %d", maxPasswordLength)
+		}
+	}
+
+	classes, ok := os.LookupEnv(passwordClassesEnvName)
+	if !ok {
+		classes = defaultPasswordClasses
+	}
+
+	cfg.requiredClasses = splitList(classes)
+	for _, class := range cfg.requiredClasses {
+		if !passwordClasses[class] {
+			return nil, errors.Errorf("unknown password character class %q", class)
+		}
+	}
+
+	if value := os.Getenv(passwordBcryptCostEnvName); len(value) != 0 {
+		cfg.bcryptCost, err = strconv.Atoi(value)
+		if err != nil || cfg.bcryptCost < bcrypt.MinCost || cfg.bcryptCost > bcrypt.MaxCost {
+			return nil, errors.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	}
+
+	if value := os.Getenv(passwordResetTTLEnvName); len(value) != 0 {
+		cfg.resetTTL, err = time.ParseDuration(value)
+		if err != nil || cfg.resetTTL <= 0 {
+			return nil, errors.New("invalid password reset ttl")
+		}
+	}
+
+	if path := os.Getenv(passwordDenylistEnvName); len(path) != 0 {
+		cfg.denylist, err = loadDenylist(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load password denylist")
+		}
+	}
+
+	return cfg, nil
+}
+
+func (cfg *passwordConfig) MinLength() int {
+	return cfg.minLength
+}
+
+func (cfg *passwordConfig) RequiredClasses() []string {
+	return cfg.requiredClasses
+}
+
+func (cfg *passwordConfig) Denylist() []string {
+	return cfg.denylist
+}
+
+func (cfg *passwordConfig) BcryptCost() int {
+	return cfg.bcryptCost
+}
+
+func (cfg *passwordConfig) ResetTTL() time.Duration {
+	return cfg.resetTTL
+}
+
+func (cfg *passwordConfig) ResetURL() string {
+	return cfg.resetURL
+}
+
+// loadDenylist skips empty lines and comments starting with #.
+func loadDenylist(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var res []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		res = append(res, line)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
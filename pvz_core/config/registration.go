@@ -0,0 +1,94 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	registrationInviteOnlyEnvName = "REGISTRATION_INVITE_ONLY"
+	inviteTTLEnvName              = "INVITE_TTL"
+	inviteURLEnvName              = "INVITE_URL"
+	emailVerificationTTLEnvName   = "EMAIL_VERIFICATION_TTL"
+	emailVerificationURLEnvName   = "EMAIL_VERIFICATION_URL"
+
+	defaultInviteTTL            = 72 * time.Hour
+	defaultEmailVerificationTTL = 24 * time.Hour
+)
+
+type RegistrationConfig interface {
+	InviteOnly() bool
+	InviteTTL() time.Duration
+	InviteURL() string
+	VerificationTTL() time.Duration
+	VerificationURL() string
+}
+
+type registrationConfig struct {
+	inviteOnly      bool
+	inviteTTL       time.Duration
+	inviteURL       string
+	verificationTTL time.Duration
+	verificationURL string
+}
+
+// NewRegistrationConfig reads how new accounts are registered. By default
+// registration requires an invite; REGISTRATION_INVITE_ONLY=false allows
+// self-registration as an employee.
+func NewRegistrationConfig() (RegistrationConfig, error) {
+	var err error
+
+	cfg := &registrationConfig{
+		inviteOnly:      true,
+		inviteTTL:       defaultInviteTTL,
+		inviteURL:       os.Getenv(inviteURLEnvName),
+		verificationTTL: defaultEmailVerificationTTL,
+		verificationURL: os.Getenv(emailVerificationURLEnvName),
+	}
+
+	if value := os.Getenv(registrationInviteOnlyEnvName); len(value) != 0 {
+		cfg.inviteOnly, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s", registrationInviteOnlyEnvName)
+		}
+	}
+
+	if value := os.Getenv(inviteTTLEnvName); len(value) != 0 {
+		cfg.inviteTTL, err = time.ParseDuration(value)
+		if err != nil || cfg.inviteTTL <= 0 {
+			return nil, errors.New("invalid invite ttl")
+		}
+	}
+
+	if value := os.Getenv(emailVerificationTTLEnvName); len(value) != 0 {
+		cfg.verificationTTL, err = time.ParseDuration(value)
+		if err != nil || cfg.verificationTTL <= 0 {
+			return nil, errors.New("invalid email verification ttl")
+		}
+	}
+
+	return cfg, nil
+}
+
+func (cfg *registrationConfig) InviteOnly() bool {
+	return cfg.inviteOnly
+}
+
+func (cfg *registrationConfig) InviteTTL() time.Duration {
+	return cfg.inviteTTL
+}
+
+func (cfg *registrationConfig) InviteURL() string {
+	return cfg.inviteURL
+}
+
+func (cfg *registrationConfig) VerificationTTL() time.Duration {
+	return cfg.verificationTTL
+}
+
+func (cfg *registrationConfig) VerificationURL() string {
+	return cfg.verificationURL
+}
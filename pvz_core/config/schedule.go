@@ -0,0 +1,62 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pvzTimezoneEnvName           = "PVZ_TIMEZONE"
+	receptionWorkingHoursEnvName = "RECEPTION_WORKING_HOURS_ONLY"
+
+	defaultPVZTimezone = "Europe/Moscow"
+)
+
+type ScheduleConfig interface {
+	Location() *time.Location
+	ReceptionWorkingHoursOnly() bool
+}
+
+type scheduleConfig struct {
+	location                  *time.Location
+	receptionWorkingHoursOnly bool
+}
+
+// NewScheduleConfig reads the time zone PVZ working hours are set in and
+// whether receptions may only be opened during working hours.
+func NewScheduleConfig() (ScheduleConfig, error) {
+	timezone := os.Getenv(pvzTimezoneEnvName)
+	if len(timezone) == 0 {
+		timezone = defaultPVZTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pvz timezone")
+	}
+
+	cfg := &scheduleConfig{
+		location: location,
+	}
+
+	if value := os.Getenv(receptionWorkingHoursEnvName); len(value) != 0 {
+		cfg.receptionWorkingHoursOnly, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid reception working hours flag")
+		}
+	}
+
+	return cfg, nil
+}
+
+func (cfg *scheduleConfig) Location() *time.Location {
+	return cfg.location
+}
+
+func (cfg *scheduleConfig) ReceptionWorkingHoursOnly() bool {
+	return cfg.receptionWorkingHoursOnly
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"net"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	hostenvName = "SERVER_HOST"
+	portenvName = "SERVER_PORT"
+
+	trustedProxiesEnvName = "SERVER_TRUSTED_PROXIES"
+)
+
+type ServerConfig interface {
+	Address() string
+	TrustedProxies() []string
+}
+
+type serverConfig struct {
+	host           string
+	port           string
+	trustedProxies []string
+}
+
+func NewServerConfig() (ServerConfig, error) {
+	host := os.Getenv(hostenvName)
+	if len(host) == 0 {
+		return nil, errors.New("server host not found")
+	}
+
+	port := os.Getenv(portenvName)
+	if len(port) == 0 {
+		return nil, errors.New("server port not found")
+	}
+
+	// Без доверенных прокси адрес клиента берётся из соединения, а не из X-Forwarded-For
+	var trustedProxies []string
+	for _, proxy := range strings.Split(os.Getenv(trustedProxiesEnvName), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			trustedProxies = append(trustedProxies, proxy)
+		}
+	}
+
+	return &serverConfig{
+		host:           host,
+		port:           port,
+		trustedProxies: trustedProxies,
+	}, nil
+}
+
+func (cfg *serverConfig) Address() string {
+	return net.JoinHostPort(cfg.host, cfg.port)
+}
+
+func (cfg *serverConfig) TrustedProxies() []string {
+	return cfg.trustedProxies
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+const (
+	keysDirEnvName   = "TOKEN_KEYS_DIR"
+	activeKidEnvName = "TOKEN_ACTIVE_KID"
+	retiredKidsEnv   = "TOKEN_RETIRED_KIDS"
+	jwksURLEnvName   = "TOKEN_JWKS_URL"
+	secretKeyEnvName = "TOKEN_SECRET_KEY"
+
+	minSecretKeySize = 32
+)
+
+type TokenConfig interface {
+	KeysDir() string
+	ActiveKid() string
+	RetiredKids() []string
+	JWKSURL() string
+	SecretKey() string
+}
+
+type tokenConfig struct {
+	keysDir     string
+	activeKid   string
+	retiredKids []string
+	jwksURL     string
+	secretKey   string
+}
+
+// NewTokenConfig prefers signing with the same private keys as the HTTP
+// service, then verifying with the public keys it publishes (AuthService can
+// not issue tokens in that mode) and falls back to the legacy HS256 shared
+// secret.
+func NewTokenConfig() (TokenConfig, error) {
+	keysDir := os.Getenv(keysDirEnvName)
+	if len(keysDir) != 0 {
+		activeKid := os.Getenv(activeKidEnvName)
+		if len(activeKid) == 0 {
+			return nil, errors.New("active signing key id not found")
+		}
+
+		return &tokenConfig{
+			keysDir:     keysDir,
+			activeKid:   activeKid,
+			retiredKids: splitList(os.Getenv(retiredKidsEnv)),
+		}, nil
+	}
+
+	jwksURL := os.Getenv(jwksURLEnvName)
+	if len(jwksURL) != 0 {
+		return &tokenConfig{
+			jwksURL: jwksURL,
+		}, nil
+	}
+
+	secretKey := os.Getenv(secretKeyEnvName)
+	if len(secretKey) == 0 {
+		return nil, errors.New("neither signing keys, jwks url nor secret key of jwt token found")
+	}
+
+	if len(secretKey) < minSecretKeySize {
+		return nil, errors.New("secret key of jwt token is too short")
+	}
+
+	return &tokenConfig{
+		secretKey: secretKey,
+	}, nil
+}
+
+func (cfg *tokenConfig) KeysDir() string {
+	return cfg.keysDir
+}
+
+func (cfg *tokenConfig) ActiveKid() string {
+	return cfg.activeKid
+}
+
+func (cfg *tokenConfig) RetiredKids() []string {
+	return cfg.retiredKids
+}
+
+func (cfg *tokenConfig) JWKSURL() string {
+	return cfg.jwksURL
+}
+
+func (cfg *tokenConfig) SecretKey() string {
+	return cfg.secretKey
+}
+
+func splitList(value string) []string {
+	var res []string
+
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			res = append(res, item)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,43 @@
+package config
+
+import "os"
+
+const (
+	twoFactorRequiredRolesEnvName = "TWO_FACTOR_REQUIRED_ROLES"
+	twoFactorIssuerEnvName        = "TWO_FACTOR_ISSUER"
+
+	defaultTwoFactorIssuer = "PVZ"
+)
+
+type TwoFactorConfig interface {
+	RequiredRoles() []string
+	Issuer() string
+}
+
+type twoFactorConfig struct {
+	requiredRoles []string
+	issuer        string
+}
+
+// NewTwoFactorConfig reads the roles that must use TOTP two-factor
+// authentication, e.g. TWO_FACTOR_REQUIRED_ROLES=moderator, and the issuer
+// shown in authenticator apps. For other roles two-factor is optional.
+func NewTwoFactorConfig() (TwoFactorConfig, error) {
+	issuer := os.Getenv(twoFactorIssuerEnvName)
+	if len(issuer) == 0 {
+		issuer = defaultTwoFactorIssuer
+	}
+
+	return &twoFactorConfig{
+		requiredRoles: splitList(os.Getenv(twoFactorRequiredRolesEnvName)),
+		issuer:        issuer,
+	}, nil
+}
+
+func (cfg *twoFactorConfig) RequiredRoles() []string {
+	return cfg.requiredRoles
+}
+
+func (cfg *twoFactorConfig) Issuer() string {
+	return cfg.issuer
+}
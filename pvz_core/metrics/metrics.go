@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+type Metrics struct {
+	PvzCountTotal       prometheus.Counter
+	ReceptionCountTotal prometheus.Counter
+	ProductsCountTotal  prometheus.Counter
+}
+
+func New() *Metrics {
+	pvzCountTotal := promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "pvz_count_total",
+			Help: "The total amount of created PVZ",
+		},
+	)
+
+	receptionCountTotal := promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "reception_count_total",
+			Help: "The total amount of created reception",
+		},
+	)
+
+	productsCountTotal := promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "products_count_total",
+			Help: "The total amount of added products",
+		},
+	)
+
+	return &Metrics{
+		PvzCountTotal:       pvzCountTotal,
+		ReceptionCountTotal: receptionCountTotal,
+		ProductsCountTotal:  productsCountTotal,
+	}
+}
@@ -0,0 +1,515 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type User struct {
+	Id        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Password  string    `json:"password_hash"`
+	Role      string    `json:"role"`
+	City      *string   `json:"city,omitempty"`
+	Disabled  bool      `json:"disabled"`
+	CreatedAt time.Time `json:"created_at"`
+
+	TwoFactorEnabled bool `json:"two_factor_enabled"`
+	EmailVerified    bool `json:"email_verified"`
+}
+
+type GetUsersReq struct {
+	Role     string `json:"role,omitempty"`
+	Disabled *bool  `json:"disabled,omitempty"`
+	Limit    int    `json:"limit"`
+	Page     int    `json:"page"`
+}
+
+type GetUsersRes struct {
+	Users    []User `json:"users"`
+	Total    int    `json:"total"`
+	NextPage int    `json:"next_page,omitempty"`
+}
+
+type UpdateUserReq struct {
+	Role     *string `json:"role,omitempty"`
+	City     *string `json:"city,omitempty"`
+	Disabled *bool   `json:"disabled,omitempty"`
+}
+
+type CreateUserReq struct {
+	Id       uuid.UUID `json:"id"`
+	Email    string    `json:"email"`
+	Password string    `json:"password"`
+	Role     string    `json:"role"`
+	City     *string   `json:"city,omitempty"`
+
+	InviteToken string `json:"invite_token,omitempty"`
+}
+
+type CreateUserRes struct {
+	Id    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
+	Role  string    `json:"role"`
+	City  *string   `json:"city,omitempty"`
+}
+
+type Role struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	CityScoped  bool     `json:"city_scoped"`
+	Permissions []string `json:"permissions"`
+}
+
+type LoginUserReq struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	IP       string `json:"-"`
+}
+
+type LoginUserRes struct {
+	Id            uuid.UUID `json:"id"`
+	Email         string    `json:"email"`
+	Password_hash string    `json:"password"`
+	Role          string    `json:"role"`
+	Disabled      bool      `json:"disabled"`
+
+	TwoFactorEnabled bool `json:"two_factor_enabled"`
+	EmailVerified    bool `json:"email_verified"`
+}
+
+type PVZReq struct {
+	User_id          uuid.UUID  `json:"user_id"`
+	City             string     `json:"city"`
+	Id               *uuid.UUID `json:"id,omitempty"`
+	RegistrationDate *time.Time `json:"registrationDate,omitempty"`
+	Address          string     `json:"address"`
+	Lat              *float64   `json:"lat,omitempty"`
+	Lon              *float64   `json:"lon,omitempty"`
+}
+
+type PVZRes struct {
+	City             string     `json:"city"`
+	Id               *uuid.UUID `json:"id,omitempty"`
+	RegistrationDate *time.Time `json:"registrationDate,omitempty"`
+	Status           string     `json:"status"`
+	Address          string     `json:"address"`
+	Lat              *float64   `json:"lat,omitempty"`
+	Lon              *float64   `json:"lon,omitempty"`
+	IsOpenNow        *bool      `json:"isOpenNow,omitempty"`
+}
+
+type UpdatePVZReq struct {
+	City    *string  `json:"city,omitempty"`
+	Status  *string  `json:"status,omitempty"`
+	Address *string  `json:"address,omitempty"`
+	Lat     *float64 `json:"lat,omitempty"`
+	Lon     *float64 `json:"lon,omitempty"`
+}
+
+type NearbyPVZReq struct {
+	Lat    float64 `json:"lat"`
+	Lon    float64 `json:"lon"`
+	Radius float64 `json:"radius"`
+	Limit  int     `json:"limit"`
+	City   string  `json:"city,omitempty"`
+}
+
+type NearbyPVZRes struct {
+	PVZ      PVZRes  `json:"pvz"`
+	Distance float64 `json:"distance"`
+}
+
+type GetPVZReq struct {
+	StartDate       *time.Time `json:"startDate,omitempty"`
+	EndTime         *time.Time `json:"endDate,omitempty"`
+	City            string     `json:"city,omitempty"`
+	PVZStatus       string     `json:"pvzStatus,omitempty"`
+	ReceptionStatus string     `json:"status,omitempty"`
+	ProductType     string     `json:"productType,omitempty"`
+	Page            int        `json:"page"`
+	Limit           int        `json:"limit"`
+	Cursor          string     `json:"cursor,omitempty"`
+}
+
+type GetPVZRes struct {
+	PVZ        []FullPVZRes `json:"pvz"`
+	Total      int          `json:"total"`
+	NextPage   int          `json:"nextPage,omitempty"`
+	NextCursor string       `json:"nextCursor,omitempty"`
+}
+
+type PVZFilter struct {
+	City      string     `json:"city,omitempty"`
+	Status    string     `json:"status,omitempty"`
+	StartDate *time.Time `json:"startDate,omitempty"`
+	EndDate   *time.Time `json:"endDate,omitempty"`
+}
+
+type PVZListReq struct {
+	PVZFilter
+	PageSize  int    `json:"pageSize"`
+	PageToken string `json:"pageToken,omitempty"`
+}
+
+type PVZListRes struct {
+	PVZ           []PVZRes `json:"pvz"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+}
+
+type City struct {
+	Id         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	IsActive   bool       `json:"isActive"`
+	LaunchDate *time.Time `json:"launchDate,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type CreateCityReq struct {
+	Name       string     `json:"name"`
+	IsActive   *bool      `json:"isActive,omitempty"`
+	LaunchDate *time.Time `json:"launchDate,omitempty"`
+}
+
+type UpdateCityReq struct {
+	IsActive   *bool      `json:"isActive,omitempty"`
+	LaunchDate *time.Time `json:"launchDate,omitempty"`
+}
+
+type PVZScheduleDay struct {
+	Weekday   int    `json:"weekday"`
+	OpenTime  string `json:"openTime"`
+	CloseTime string `json:"closeTime"`
+}
+
+type PVZScheduleException struct {
+	Date      time.Time `json:"date"`
+	IsClosed  bool      `json:"isClosed"`
+	OpenTime  *string   `json:"openTime,omitempty"`
+	CloseTime *string   `json:"closeTime,omitempty"`
+}
+
+// PVZScheduleOnDate is the part of the PVZ schedule that applies to one
+// date: the weekly hours of its weekday and the exception for the date.
+type PVZScheduleOnDate struct {
+	HasSchedule bool
+	Day         *PVZScheduleDay
+	Exception   *PVZScheduleException
+}
+
+type PVZSchedule struct {
+	PVZId      uuid.UUID              `json:"pvzId"`
+	Days       []PVZScheduleDay       `json:"days"`
+	Exceptions []PVZScheduleException `json:"exceptions"`
+}
+
+type PVZStaff struct {
+	PVZId      uuid.UUID `json:"pvzId"`
+	UserId     uuid.UUID `json:"userId"`
+	Email      string    `json:"email"`
+	AssignedAt time.Time `json:"assignedAt"`
+}
+
+type ProductType struct {
+	Code        string    `json:"code"`
+	DisplayName string    `json:"displayName"`
+	Fragile     bool      `json:"fragile"`
+	Oversized   bool      `json:"oversized"`
+	IsActive    bool      `json:"isActive"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type CreateProductTypeReq struct {
+	Code        string `json:"code"`
+	DisplayName string `json:"displayName"`
+	Fragile     bool   `json:"fragile"`
+	Oversized   bool   `json:"oversized"`
+	IsActive    *bool  `json:"isActive,omitempty"`
+}
+
+type UpdateProductTypeReq struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Fragile     *bool   `json:"fragile,omitempty"`
+	Oversized   *bool   `json:"oversized,omitempty"`
+	IsActive    *bool   `json:"isActive,omitempty"`
+}
+
+type CreateReceptionRes struct {
+	Id       uuid.UUID `json:"id"`
+	DateTime time.Time `json:"created_at"`
+	PvzId    uuid.UUID `json:"pvz_id"`
+	Status   string    `json:"status"`
+}
+
+type LastReceptionRes struct {
+	Id     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
+
+type ReceptionDetails struct {
+	Id            uuid.UUID      `json:"id"`
+	PvzId         uuid.UUID      `json:"pvz_id"`
+	Status        string         `json:"status"`
+	CreatedBy     uuid.UUID      `json:"created_by"`
+	CreatedAt     time.Time      `json:"created_at"`
+	ClosedBy      *uuid.UUID     `json:"closed_by,omitempty"`
+	ClosedAt      *time.Time     `json:"closed_at,omitempty"`
+	ProductCounts map[string]int `json:"product_counts"`
+}
+
+type GetReceptionsReq struct {
+	PvzId  uuid.UUID  `json:"pvz_id"`
+	Status string     `json:"status,omitempty"`
+	From   *time.Time `json:"from,omitempty"`
+	To     *time.Time `json:"to,omitempty"`
+	Cursor string     `json:"cursor,omitempty"`
+	Limit  int        `json:"limit"`
+}
+
+type GetReceptionsRes struct {
+	Receptions []ReceptionDetails `json:"receptions"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+type GetReceptionReq struct {
+	ReceptionId uuid.UUID `json:"reception_id"`
+	Cursor      string    `json:"cursor,omitempty"`
+	Limit       int       `json:"limit"`
+}
+
+// GetReceptionRes carries one page of the reception products.
+type GetReceptionRes struct {
+	Reception  ReceptionDetails `json:"reception"`
+	Products   []ProductRes     `json:"products"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+type CreateProductReq struct {
+	UserId      uuid.UUID `json:"user_id"`
+	ReceptionId uuid.UUID `json:"reception_id"`
+	PvzId       uuid.UUID `json:"pvz_id"`
+	ProductType string    `json:"product_type"`
+}
+
+type CreateProductRes struct {
+	Id          uuid.UUID `json:"id"`
+	DateTime    time.Time `json:"created_at"`
+	ProductType string    `json:"product_type"`
+	ReceptionId uuid.UUID `json:"reception_id"`
+}
+
+type ProductRes struct {
+	Id          uuid.UUID `json:"id"`
+	ProductType string    `json:"product_type"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ReceptionRes struct {
+	Id        uuid.UUID    `json:"id"`
+	Status    string       `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+	Products  []ProductRes `json:"products"`
+}
+
+type FullPVZRes struct {
+	City             string         `json:"city"`
+	Id               *uuid.UUID     `json:"id,omitempty"`
+	RegistrationDate *time.Time     `json:"registration_date,omitempty"`
+	Status           string         `json:"status"`
+	Address          string         `json:"address"`
+	Lat              *float64       `json:"lat,omitempty"`
+	Lon              *float64       `json:"lon,omitempty"`
+	IsOpenNow        *bool          `json:"is_open_now,omitempty"`
+	Receptions       []ReceptionRes `json:"receptions"`
+}
+
+type TokenPairRes struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// LoginRes holds the token pair or, when the account uses two-factor
+// authentication, the challenge to complete with a TOTP or recovery code.
+type LoginRes struct {
+	Tokens    TokenPairRes           `json:"tokens"`
+	Challenge *TwoFactorChallengeRes `json:"challenge,omitempty"`
+}
+
+type TwoFactorChallengeRes struct {
+	ChallengeToken     string    `json:"challengeToken"`
+	ExpiresAt          time.Time `json:"expiresAt"`
+	EnrollmentRequired bool      `json:"enrollmentRequired"`
+}
+
+type TwoFactorLoginReq struct {
+	ChallengeToken string `json:"challengeToken"`
+	Code           string `json:"code"`
+}
+
+type TwoFactorEnrollmentRes struct {
+	Secret        string   `json:"secret"`
+	URI           string   `json:"otpauthUri"`
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type TwoFactorState struct {
+	Secret   *string `json:"-"`
+	Enabled  bool    `json:"enabled"`
+	LastStep int64   `json:"-"`
+}
+
+type TwoFactorChallengeReq struct {
+	UserId    uuid.UUID `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type TwoFactorChallenge struct {
+	Id        uuid.UUID `json:"id"`
+	UserId    uuid.UUID `json:"user_id"`
+	Attempts  int       `json:"attempts"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type APIKey struct {
+	Id          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"key_prefix"`
+	UserId      uuid.UUID  `json:"user_id"`
+	Permissions []string   `json:"permissions"`
+	PvzId       *uuid.UUID `json:"pvz_id,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedBy   uuid.UUID  `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+}
+
+type CreateAPIKeyReq struct {
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+	PvzId       *uuid.UUID `json:"pvz_id,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedBy   uuid.UUID  `json:"created_by"`
+}
+
+// CreateAPIKeyRes carries the plain key, it is shown only once.
+type CreateAPIKeyRes struct {
+	APIKey APIKey `json:"api_key"`
+	Key    string `json:"key"`
+}
+
+type APIKeyReq struct {
+	Id          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"key_prefix"`
+	KeyHash     string     `json:"key_hash"`
+	UserId      uuid.UUID  `json:"user_id"`
+	Permissions []string   `json:"permissions"`
+	PvzId       *uuid.UUID `json:"pvz_id,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	CreatedBy   uuid.UUID  `json:"created_by"`
+}
+
+type RefreshTokenReq struct {
+	UserId    uuid.UUID `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type RefreshTokenRes struct {
+	Id        uuid.UUID  `json:"id"`
+	UserId    uuid.UUID  `json:"user_id"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+type LogoutReq struct {
+	UserId         uuid.UUID `json:"user_id"`
+	TokenId        string    `json:"jti"`
+	TokenExpiresAt time.Time `json:"expires_at"`
+	RefreshToken   string    `json:"refresh_token"`
+}
+
+type ChangePasswordReq struct {
+	UserId          uuid.UUID `json:"user_id"`
+	CurrentPassword string    `json:"currentPassword"`
+	NewPassword     string    `json:"newPassword"`
+}
+
+type PasswordResetReq struct {
+	Email string `json:"email"`
+	IP    string `json:"-"`
+}
+
+type ResetPasswordReq struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
+
+type PasswordResetTokenReq struct {
+	UserId    uuid.UUID `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type PasswordResetTokenRes struct {
+	Id        uuid.UUID  `json:"id"`
+	UserId    uuid.UUID  `json:"user_id"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+type Invite struct {
+	Id        uuid.UUID  `json:"id"`
+	Email     *string    `json:"email,omitempty"`
+	Role      string     `json:"role"`
+	City      *string    `json:"city,omitempty"`
+	CreatedBy uuid.UUID  `json:"created_by"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	UsedBy    *uuid.UUID `json:"used_by,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type CreateInviteReq struct {
+	Email     *string   `json:"email,omitempty"`
+	Role      string    `json:"role"`
+	City      *string   `json:"city,omitempty"`
+	CreatedBy uuid.UUID `json:"created_by"`
+}
+
+// CreateInviteRes carries the plain invite token, it is shown only once.
+type CreateInviteRes struct {
+	Invite Invite `json:"invite"`
+	Token  string `json:"token"`
+}
+
+type InviteReq struct {
+	Email     *string   `json:"email,omitempty"`
+	Role      string    `json:"role"`
+	City      *string   `json:"city,omitempty"`
+	TokenHash string    `json:"token_hash"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type ResendEmailVerificationReq struct {
+	Email string `json:"email"`
+	IP    string `json:"-"`
+}
+
+type EmailVerificationTokenReq struct {
+	UserId    uuid.UUID `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type EmailVerificationTokenRes struct {
+	Id        uuid.UUID  `json:"id"`
+	UserId    uuid.UUID  `json:"user_id"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
@@ -0,0 +1,43 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points at the last row of a page ordered by (created_at, id).
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	Id        uuid.UUID `json:"id"`
+}
+
+func Encode(c Cursor) string {
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func Decode(token string) (Cursor, error) {
+	var c Cursor
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, ErrInvalidCursor
+	}
+
+	if c.Id == uuid.Nil || c.CreatedAt.IsZero() {
+		return c, ErrInvalidCursor
+	}
+
+	return c, nil
+}
@@ -0,0 +1,41 @@
+package cursor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	c := Cursor{
+		CreatedAt: time.Date(2025, 4, 1, 12, 30, 0, 123456000, time.UTC),
+		Id:        uuid.New(),
+	}
+
+	got, err := Decode(Encode(c))
+	require.NoError(t, err)
+	require.True(t, c.CreatedAt.Equal(got.CreatedAt))
+	require.Equal(t, c.Id, got.Id)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"Empty token", ""},
+		{"Not base64", "!!!"},
+		{"Not json", "bm90LWpzb24"},
+		{"Missing id", Encode(Cursor{CreatedAt: time.Now()})},
+		{"Missing time", Encode(Cursor{Id: uuid.New()})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.token)
+			require.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
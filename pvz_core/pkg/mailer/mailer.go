@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers emails to users. Implementations must be safe for
+// concurrent use.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogSender writes emails to the log instead of sending them, for local use.
+type LogSender struct {
+	log zerolog.Logger
+}
+
+func NewLogSender(log zerolog.Logger) *LogSender {
+	return &LogSender{
+		log: log,
+	}
+}
+
+func (snd *LogSender) Send(ctx context.Context, msg Message) error {
+	snd.log.Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Str("body", msg.Body).
+		Msg("email sent")
+
+	return nil
+}
+
+// FileSender appends emails to a file, for local use and tests.
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{
+		path: path,
+	}
+}
+
+func (snd *FileSender) Send(ctx context.Context, msg Message) error {
+	snd.mu.Lock()
+	defer snd.mu.Unlock()
+
+	file, err := os.OpenFile(snd.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open mail file: %w", err)
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC1123Z), msg.To, msg.Subject, msg.Body)
+	if err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+
+	return nil
+}
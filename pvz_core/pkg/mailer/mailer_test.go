@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.txt")
+	sender := NewFileSender(path)
+
+	messages := []Message{
+		{To: "first@mail.ru", Subject: "Сброс пароля", Body: "token-1"},
+		{To: "second@mail.ru", Subject: "Сброс пароля", Body: "token-2"},
+	}
+
+	for _, msg := range messages {
+		require.NoError(t, sender.Send(context.Background(), msg))
+	}
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	for _, msg := range messages {
+		require.Contains(t, string(content), "To: "+msg.To+"\n")
+		require.Contains(t, string(content), msg.Body)
+	}
+
+	require.Equal(t, 2, strings.Count(string(content), "Subject: Сброс пароля"))
+}
@@ -12,6 +12,9 @@ type UserClaims struct {
 	ID    uuid.UUID `json:"id"`
 	Email string    `json:"email"`
 	Role  string    `json:"role"`
+	// Dummy marks tokens issued by /dummyLogin, so their requests can be told
+	// apart in logs and metrics.
+	Dummy bool `json:"dummy,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -0,0 +1,114 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWK is a public key in JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func NewJWKS(keys []*Key) JWKS {
+	jwks := JWKS{Keys: make([]JWK, 0, len(keys))}
+
+	for _, key := range keys {
+		jwk := JWK{
+			Kid: key.Kid,
+			Use: "sig",
+			Alg: key.Method.Alg(),
+		}
+
+		switch public := key.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		default:
+			continue
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
+
+// ParseJWKS builds a verification-only key set from a JWKS document.
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var jwks JWKS
+
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("error decoding jwks: %w", err)
+	}
+
+	keys := make([]*Key, 0, len(jwks.Keys))
+
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return NewKeySet("", keys...)
+}
+
+func (jwk JWK) publicKey() (*Key, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid modulus: %w", jwk.Kid, err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid exponent: %w", jwk.Kid, err)
+		}
+
+		public := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+
+		return &Key{Kid: jwk.Kid, Method: jwt.SigningMethodRS256, Public: public}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("key %q: unsupported curve %q", jwk.Kid, jwk.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("key %q: invalid public key", jwk.Kid)
+		}
+
+		return &Key{Kid: jwk.Kid, Method: jwt.SigningMethodEdDSA, Public: ed25519.PublicKey(x)}, nil
+	default:
+		return nil, fmt.Errorf("key %q: unsupported key type %q", jwk.Kid, jwk.Kty)
+	}
+}
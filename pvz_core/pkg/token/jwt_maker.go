@@ -0,0 +1,185 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// RevocationList reports whether an access token was revoked (e.g. on logout)
+// before its expiration. Tokens are identified by their jti claim.
+type RevocationList interface {
+	IsRevoked(ctx context.Context, tokenId string) (bool, error)
+}
+
+// UserStatus reports whether the account a token was issued to has been
+// disabled or has had its tokens issued before issuedAt revoked, e.g. on a role
+// change, so such tokens stop working before they expire.
+type UserStatus interface {
+	IsUserTokenRevoked(ctx context.Context, userId uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+// JWTMaker signs tokens either with a shared HS256 secret (legacy mode) or with
+// the active asymmetric key of a KeyProvider, in which case the kid header
+// selects the verification key.
+type JWTMaker struct {
+	secretKey     string
+	keys          KeyProvider
+	revoked       RevocationList
+	users         UserStatus
+	dummyDisabled bool
+}
+
+func NewJWTMaker(secretKey string) *JWTMaker {
+	return &JWTMaker{secretKey: secretKey}
+}
+
+func NewJWTMakerWithKeys(keys KeyProvider) *JWTMaker {
+	return &JWTMaker{keys: keys}
+}
+
+func (maker *JWTMaker) SetRevocationList(revoked RevocationList) {
+	maker.revoked = revoked
+}
+
+func (maker *JWTMaker) SetUserStatus(users UserStatus) {
+	maker.users = users
+}
+
+// DisableDummyTokens makes VerifyToken reject tokens issued by CreateDummyToken,
+// including the ones signed before dummy login was turned off.
+func (maker *JWTMaker) DisableDummyTokens() {
+	maker.dummyDisabled = true
+}
+
+func (maker *JWTMaker) CreateToken(id uuid.UUID, email string, role string, duration time.Duration) (string, *UserClaims, error) {
+	claims, err := NewUserClaims(id, email, role, duration)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return maker.sign(claims)
+}
+
+// CreateDummyToken issues a token carrying the dummy claim.
+func (maker *JWTMaker) CreateDummyToken(id uuid.UUID, email string, role string, duration time.Duration) (string, *UserClaims, error) {
+	claims, err := NewUserClaims(id, email, role, duration)
+	if err != nil {
+		return "", nil, err
+	}
+
+	claims.Dummy = true
+
+	return maker.sign(claims)
+}
+
+func (maker *JWTMaker) sign(claims *UserClaims) (string, *UserClaims, error) {
+	if maker.keys == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		tokenStr, err := token.SignedString([]byte(maker.secretKey))
+		if err != nil {
+			return "", nil, fmt.Errorf("error signing token: %w", err)
+		}
+
+		return tokenStr, claims, nil
+	}
+
+	key, err := maker.keys.SigningKey()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting signing key: %w", err)
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.Kid
+
+	tokenStr, err := token.SignedString(key.Private)
+	if err != nil {
+		return "", nil, fmt.Errorf("error signing token: %w", err)
+	}
+
+	return tokenStr, claims, nil
+}
+
+func (maker *JWTMaker) VerifyToken(ctx context.Context, tokenStr string) (*UserClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if maker.keys == nil {
+			// verify the signing method
+			_, ok := token.Method.(*jwt.SigningMethodHMAC)
+			if !ok {
+				return nil, fmt.Errorf("invalid token signing method")
+			}
+
+			return []byte(maker.secretKey), nil
+		}
+
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, fmt.Errorf("token key id is missing")
+		}
+
+		key, err := maker.keys.VerificationKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("invalid token signing method")
+		}
+
+		return key.Public, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*UserClaims)
+	if !ok {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	if claims.Dummy && maker.dummyDisabled {
+		return nil, fmt.Errorf("dummy tokens are disabled")
+	}
+
+	if maker.revoked != nil {
+		revoked, err := maker.revoked.IsRevoked(ctx, claims.RegisteredClaims.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error checking token revocation: %w", err)
+		}
+
+		if revoked {
+			return nil, fmt.Errorf("token has been revoked")
+		}
+	}
+
+	if maker.users != nil {
+		var issuedAt time.Time
+		if claims.IssuedAt != nil {
+			issuedAt = claims.IssuedAt.Time
+		}
+
+		revoked, err := maker.users.IsUserTokenRevoked(ctx, claims.ID, issuedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error checking user status: %w", err)
+		}
+
+		if revoked {
+			return nil, fmt.Errorf("user is disabled or user tokens have been revoked")
+		}
+	}
+
+	return claims, nil
+}
+
+// JWKS publishes the public keys accepted by VerifyToken. In HS256 mode the
+// set is empty: the shared secret must never leave the service.
+func (maker *JWTMaker) JWKS() JWKS {
+	if maker.keys == nil {
+		return JWKS{Keys: []JWK{}}
+	}
+
+	return NewJWKS(maker.keys.PublicKeys())
+}
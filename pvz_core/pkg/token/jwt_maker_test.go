@@ -0,0 +1,232 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secretKey = "testsecret"
+
+func TestJWTMaker(t *testing.T) {
+	maker := NewJWTMaker(secretKey)
+
+	uesrId, err := uuid.NewRandom()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		id           uuid.UUID
+		email        string
+		role         string
+		duration     time.Duration
+		shouldExpire bool
+		shouldFail   bool
+		signingNone  bool
+	}{
+		{
+			name:     "Valid Token",
+			id:       uesrId,
+			email:    "test@mail.ru",
+			duration: time.Minute,
+		},
+		{
+			name:         "Expired Token",
+			id:           uesrId,
+			email:        "expiredMail@gmail.com",
+			role:         "moderator",
+			duration:     -time.Minute,
+			shouldExpire: true,
+			shouldFail:   true,
+		},
+		{
+			name:        "Invalid Signing Method",
+			id:          uesrId,
+			email:       "",
+			role:        "employee",
+			duration:    time.Minute,
+			signingNone: true,
+			shouldFail:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tokenStr string
+
+			var claims *UserClaims
+
+			var err error
+
+			if tt.signingNone {
+				claims, _ = NewUserClaims(tt.id, tt.email, tt.role, tt.duration)
+				token := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+				tokenStr, _ = token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+			} else {
+				tokenStr, claims, err = maker.CreateToken(tt.id, tt.email, tt.role, tt.duration)
+				assert.NoError(t, err, "CreateToken should not return an error")
+				assert.NotEmpty(t, tokenStr, "Token should not be empty")
+				assert.NotNil(t, claims, "Claims should not be nil")
+			}
+
+			parsedClaims, err := maker.VerifyToken(context.Background(), tokenStr)
+
+			if tt.shouldFail {
+				assert.Error(t, err, "VerifyToken should return an error")
+				assert.Nil(t, parsedClaims, "Claims should be nil for invalid tokens")
+			} else {
+				assert.NoError(t, err, "VerifyToken should not return an error")
+				assert.NotNil(t, parsedClaims, "Claims should not be nil")
+				assert.Equal(t, tt.id, parsedClaims.ID, "UserID should match")
+				assert.Equal(t, tt.email, parsedClaims.Email, "Email should match")
+
+				if tt.shouldExpire {
+					assert.True(t, parsedClaims.ExpiresAt.Before(time.Now()), "Token should be expired")
+				} else {
+					assert.WithinDuration(t, time.Now().Add(tt.duration),
+						parsedClaims.ExpiresAt.Time, time.Second, "Expiration time should be correct")
+				}
+			}
+		})
+	}
+}
+
+type revocationListStub struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (stub *revocationListStub) IsRevoked(_ context.Context, tokenId string) (bool, error) {
+	return stub.revoked[tokenId], stub.err
+}
+
+func TestJWTMakerRevocation(t *testing.T) {
+	userId, err := uuid.NewRandom()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		revoke     bool
+		listErr    error
+		shouldFail bool
+	}{
+		{
+			name: "Not Revoked Token",
+		},
+		{
+			name:       "Revoked Token",
+			revoke:     true,
+			shouldFail: true,
+		},
+		{
+			name:       "Revocation List Unavailable",
+			listErr:    errors.New("connection refused"),
+			shouldFail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maker := NewJWTMaker(secretKey)
+			list := &revocationListStub{revoked: map[string]bool{}, err: tt.listErr}
+			maker.SetRevocationList(list)
+
+			tokenStr, claims, err := maker.CreateToken(userId, "test@mail.ru", "employee", time.Minute)
+			require.NoError(t, err)
+
+			if tt.revoke {
+				list.revoked[claims.RegisteredClaims.ID] = true
+			}
+
+			parsedClaims, err := maker.VerifyToken(context.Background(), tokenStr)
+
+			if tt.shouldFail {
+				assert.Error(t, err, "VerifyToken should return an error")
+				assert.Nil(t, parsedClaims, "Claims should be nil for revoked tokens")
+			} else {
+				assert.NoError(t, err, "VerifyToken should not return an error")
+				assert.Equal(t, claims.RegisteredClaims.ID, parsedClaims.RegisteredClaims.ID, "Token ID should match")
+			}
+		})
+	}
+}
+
+type userStatusStub struct {
+	disabled   map[uuid.UUID]bool
+	validAfter map[uuid.UUID]time.Time
+}
+
+func (stub *userStatusStub) IsUserTokenRevoked(_ context.Context, userId uuid.UUID, issuedAt time.Time) (bool, error) {
+	validAfter, ok := stub.validAfter[userId]
+	return stub.disabled[userId] || (ok && validAfter.After(issuedAt)), nil
+}
+
+func TestJWTMakerDisabledUser(t *testing.T) {
+	activeUser, disabledUser := uuid.New(), uuid.New()
+
+	maker := NewJWTMaker(secretKey)
+	maker.SetUserStatus(&userStatusStub{disabled: map[uuid.UUID]bool{disabledUser: true}})
+
+	tokenStr, _, err := maker.CreateToken(activeUser, "active@mail.ru", "employee", time.Minute)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(context.Background(), tokenStr)
+	assert.NoError(t, err, "VerifyToken should accept tokens of active users")
+
+	tokenStr, _, err = maker.CreateToken(disabledUser, "disabled@mail.ru", "employee", time.Minute)
+	require.NoError(t, err)
+
+	claims, err := maker.VerifyToken(context.Background(), tokenStr)
+	assert.Error(t, err, "VerifyToken should reject tokens of disabled users")
+	assert.Nil(t, claims)
+}
+
+func TestJWTMakerRevokedUserTokens(t *testing.T) {
+	userId := uuid.New()
+	stub := &userStatusStub{validAfter: map[uuid.UUID]time.Time{}}
+
+	maker := NewJWTMaker(secretKey)
+	maker.SetUserStatus(stub)
+
+	tokenStr, _, err := maker.CreateToken(userId, "user@mail.ru", "moderator", time.Minute)
+	require.NoError(t, err)
+
+	_, err = maker.VerifyToken(context.Background(), tokenStr)
+	require.NoError(t, err)
+
+	stub.validAfter[userId] = time.Now().Add(time.Second)
+
+	claims, err := maker.VerifyToken(context.Background(), tokenStr)
+	assert.Error(t, err, "VerifyToken should reject tokens issued before the user tokens were revoked")
+	assert.Nil(t, claims)
+}
+
+func TestJWTMakerDummyToken(t *testing.T) {
+	maker := NewJWTMaker(secretKey)
+
+	tokenStr, _, err := maker.CreateDummyToken(uuid.New(), "dummy@mail.ru", "moderator", time.Minute)
+	require.NoError(t, err)
+
+	claims, err := maker.VerifyToken(context.Background(), tokenStr)
+	require.NoError(t, err)
+	assert.True(t, claims.Dummy, "dummy tokens should carry the dummy claim")
+
+	regularStr, _, err := maker.CreateToken(uuid.New(), "user@mail.ru", "moderator", time.Minute)
+	require.NoError(t, err)
+
+	maker.DisableDummyTokens()
+
+	claims, err = maker.VerifyToken(context.Background(), tokenStr)
+	assert.Error(t, err, "VerifyToken should reject dummy tokens once they are disabled")
+	assert.Nil(t, claims)
+
+	claims, err = maker.VerifyToken(context.Background(), regularStr)
+	require.NoError(t, err, "VerifyToken should still accept regular tokens")
+	assert.False(t, claims.Dummy)
+}
@@ -0,0 +1,193 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	keyFileExt = ".pem"
+
+	minRSAKeyBits = 2048
+)
+
+// Key is a single asymmetric key identified by kid. Keys loaded from a JWKS
+// document carry only the public part and can not sign tokens.
+type Key struct {
+	Kid     string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+	Retired bool
+}
+
+// KeyProvider supplies the key used to sign new tokens and resolves keys
+// referenced by the kid header of incoming tokens.
+type KeyProvider interface {
+	SigningKey() (*Key, error)
+	VerificationKey(ctx context.Context, kid string) (*Key, error)
+	PublicKeys() []*Key
+}
+
+type KeySet struct {
+	activeKid string
+	keys      map[string]*Key
+}
+
+func NewKeySet(activeKid string, keys ...*Key) (*KeySet, error) {
+	set := &KeySet{
+		activeKid: activeKid,
+		keys:      make(map[string]*Key, len(keys)),
+	}
+
+	for _, key := range keys {
+		if _, ok := set.keys[key.Kid]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", key.Kid)
+		}
+
+		set.keys[key.Kid] = key
+	}
+
+	if activeKid == "" {
+		return set, nil
+	}
+
+	active, ok := set.keys[activeKid]
+	if !ok {
+		return nil, fmt.Errorf("active key %q not found", activeKid)
+	}
+
+	if active.Private == nil {
+		return nil, fmt.Errorf("active key %q has no private part", activeKid)
+	}
+
+	if active.Retired {
+		return nil, fmt.Errorf("active key %q is retired", activeKid)
+	}
+
+	return set, nil
+}
+
+// LoadKeySet reads every <kid>.pem private key from dir. Keys listed in retired
+// are neither published nor accepted for verification any more.
+func LoadKeySet(dir string, activeKid string, retired []string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+keyFileExt))
+	if err != nil {
+		return nil, fmt.Errorf("error listing key files: %w", err)
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", dir)
+	}
+
+	retiredSet := make(map[string]bool, len(retired))
+	for _, kid := range retired {
+		retiredSet[kid] = true
+	}
+
+	keys := make([]*Key, 0, len(paths))
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading key file: %w", err)
+		}
+
+		kid := strings.TrimSuffix(filepath.Base(path), keyFileExt)
+
+		key, err := ParsePrivateKey(kid, data)
+		if err != nil {
+			return nil, err
+		}
+
+		key.Retired = retiredSet[kid]
+		keys = append(keys, key)
+	}
+
+	return NewKeySet(activeKid, keys...)
+}
+
+// ParsePrivateKey accepts PKCS#8 RSA/Ed25519 and PKCS#1 RSA keys in PEM form.
+func ParsePrivateKey(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key %q: invalid PEM data", kid)
+	}
+
+	var (
+		parsed interface{}
+		err    error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", kid, err)
+	}
+
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		if private.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("key %q: RSA key must be at least %d bits", kid, minRSAKeyBits)
+		}
+
+		return &Key{Kid: kid, Method: jwt.SigningMethodRS256, Private: private, Public: &private.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return &Key{Kid: kid, Method: jwt.SigningMethodEdDSA, Private: private, Public: private.Public()}, nil
+	default:
+		return nil, fmt.Errorf("key %q: unsupported key type %T", kid, parsed)
+	}
+}
+
+func (set *KeySet) SigningKey() (*Key, error) {
+	if set.activeKid == "" {
+		return nil, fmt.Errorf("no active signing key")
+	}
+
+	return set.keys[set.activeKid], nil
+}
+
+func (set *KeySet) VerificationKey(_ context.Context, kid string) (*Key, error) {
+	key, ok := set.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	if key.Retired {
+		return nil, fmt.Errorf("key %q is retired", kid)
+	}
+
+	return key, nil
+}
+
+func (set *KeySet) PublicKeys() []*Key {
+	keys := make([]*Key, 0, len(set.keys))
+
+	for _, key := range set.keys {
+		if !key.Retired {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Kid < keys[j].Kid
+	})
+
+	return keys
+}
@@ -0,0 +1,166 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generatePEM(t *testing.T, private interface{}) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestKeySetSignAndVerify(t *testing.T) {
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	rsaKey, err := ParsePrivateKey("rsa-1", generatePEM(t, rsaPrivate))
+	require.NoError(t, err)
+
+	edKey, err := ParsePrivateKey("ed-1", generatePEM(t, edPrivate))
+	require.NoError(t, err)
+
+	userId, err := uuid.NewRandom()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		signKid    string
+		verifyKeys func() *KeySet
+		shouldFail bool
+	}{
+		{
+			name:    "RS256 Active Key",
+			signKid: "rsa-1",
+			verifyKeys: func() *KeySet {
+				set, _ := NewKeySet("rsa-1", rsaKey, edKey)
+				return set
+			},
+		},
+		{
+			name:    "EdDSA Active Key",
+			signKid: "ed-1",
+			verifyKeys: func() *KeySet {
+				set, _ := NewKeySet("ed-1", rsaKey, edKey)
+				return set
+			},
+		},
+		{
+			name:    "Previous Key After Rotation",
+			signKid: "rsa-1",
+			verifyKeys: func() *KeySet {
+				set, _ := NewKeySet("ed-1", rsaKey, edKey)
+				return set
+			},
+		},
+		{
+			name:    "Retired Key",
+			signKid: "rsa-1",
+			verifyKeys: func() *KeySet {
+				retired := *rsaKey
+				retired.Retired = true
+				set, _ := NewKeySet("ed-1", &retired, edKey)
+				return set
+			},
+			shouldFail: true,
+		},
+		{
+			name:    "Unknown Key",
+			signKid: "rsa-1",
+			verifyKeys: func() *KeySet {
+				set, _ := NewKeySet("ed-1", edKey)
+				return set
+			},
+			shouldFail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signKeys, err := NewKeySet(tt.signKid, rsaKey, edKey)
+			require.NoError(t, err)
+
+			tokenStr, _, err := NewJWTMakerWithKeys(signKeys).CreateToken(userId, "test@mail.ru", "moderator", time.Minute)
+			require.NoError(t, err)
+
+			parsedClaims, err := NewJWTMakerWithKeys(tt.verifyKeys()).VerifyToken(context.Background(), tokenStr)
+
+			if tt.shouldFail {
+				assert.Error(t, err, "VerifyToken should return an error")
+				assert.Nil(t, parsedClaims, "Claims should be nil for invalid tokens")
+			} else {
+				assert.NoError(t, err, "VerifyToken should not return an error")
+				assert.Equal(t, userId, parsedClaims.ID, "UserID should match")
+			}
+		})
+	}
+}
+
+func TestJWKSRoundTrip(t *testing.T) {
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	rsaKey, err := ParsePrivateKey("rsa-1", generatePEM(t, rsaPrivate))
+	require.NoError(t, err)
+
+	edKey, err := ParsePrivateKey("ed-1", generatePEM(t, edPrivate))
+	require.NoError(t, err)
+
+	keys, err := NewKeySet("ed-1", rsaKey, edKey)
+	require.NoError(t, err)
+
+	signer := NewJWTMakerWithKeys(keys)
+
+	data, err := json.Marshal(signer.JWKS())
+	require.NoError(t, err)
+
+	published, err := ParseJWKS(data)
+	require.NoError(t, err)
+	assert.Len(t, published.PublicKeys(), 2, "Both keys should be published")
+
+	verifier := NewJWTMakerWithKeys(published)
+
+	tokenStr, _, err := signer.CreateToken(uuid.New(), "test@mail.ru", "employee", time.Minute)
+	require.NoError(t, err)
+
+	_, err = verifier.VerifyToken(context.Background(), tokenStr)
+	assert.NoError(t, err, "Token should be verified with published keys")
+
+	_, _, err = verifier.CreateToken(uuid.New(), "test@mail.ru", "employee", time.Minute)
+	assert.Error(t, err, "Verification-only key set should not sign tokens")
+
+	hsToken, _, err := NewJWTMaker(secretKey).CreateToken(uuid.New(), "test@mail.ru", "employee", time.Minute)
+	require.NoError(t, err)
+
+	_, err = verifier.VerifyToken(context.Background(), hsToken)
+	assert.Error(t, err, "HS256 token without kid should be rejected")
+
+	assert.Empty(t, NewJWTMaker(secretKey).JWKS().Keys, "Shared secret should never be published")
+}
+
+func TestParsePrivateKeyRejectsWeakRSA(t *testing.T) {
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	_, err = ParsePrivateKey("weak", generatePEM(t, weak))
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	remoteKeysTTL        = 5 * time.Minute
+	remoteKeysMinRefresh = 10 * time.Second
+	remoteKeysTimeout    = 5 * time.Second
+	remoteKeysMaxSize    = 1 << 20
+)
+
+// RemoteKeySet is a verification-only KeyProvider backed by the JWKS endpoint
+// of the token issuer. Keys are cached and re-fetched when the cache expires or
+// a token references an unknown kid, so rotated keys are picked up without a
+// restart.
+type RemoteKeySet struct {
+	url    string
+	client *http.Client
+
+	mu      sync.RWMutex
+	keys    *KeySet
+	fetched time.Time
+}
+
+func NewRemoteKeySet(url string) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:    url,
+		client: &http.Client{Timeout: remoteKeysTimeout},
+	}
+}
+
+func (rks *RemoteKeySet) SigningKey() (*Key, error) {
+	return nil, fmt.Errorf("remote key set can not sign tokens")
+}
+
+func (rks *RemoteKeySet) VerificationKey(ctx context.Context, kid string) (*Key, error) {
+	rks.mu.RLock()
+	keys, fetched := rks.keys, rks.fetched
+	rks.mu.RUnlock()
+
+	if keys != nil && time.Since(fetched) < remoteKeysTTL {
+		key, err := keys.VerificationKey(ctx, kid)
+		if err == nil || time.Since(fetched) < remoteKeysMinRefresh {
+			return key, err
+		}
+	}
+
+	keys, err := rks.refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys.VerificationKey(ctx, kid)
+}
+
+func (rks *RemoteKeySet) PublicKeys() []*Key {
+	rks.mu.RLock()
+	defer rks.mu.RUnlock()
+
+	if rks.keys == nil {
+		return nil
+	}
+
+	return rks.keys.PublicKeys()
+}
+
+func (rks *RemoteKeySet) refresh(ctx context.Context) (*KeySet, error) {
+	rks.mu.Lock()
+	defer rks.mu.Unlock()
+
+	// another request may have refreshed the cache while we were waiting
+	if rks.keys != nil && time.Since(rks.fetched) < remoteKeysMinRefresh {
+		return rks.keys, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rks.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building jwks request: %w", err)
+	}
+
+	resp, err := rks.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, remoteKeysMaxSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading jwks: %w", err)
+	}
+
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	rks.keys = keys
+	rks.fetched = time.Now()
+
+	return keys, nil
+}
@@ -0,0 +1,74 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The seed of the SHA1 test vectors from RFC 6238, appendix B.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		time int64
+		want string
+	}{
+		{"First step", 59, "287082"},
+		{"Year 2005", 1111111109, "081804"},
+		{"Year 2009", 1234567890, "005924"},
+		{"Year 2033", 2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Code(rfcSecret, Step(time.Unix(tt.time, 0)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, code)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+
+	previous, err := Code(secret, Step(now)-1)
+	require.NoError(t, err)
+
+	stale, err := Code(secret, Step(now)-3)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		code     string
+		wantOk   bool
+		wantStep int64
+	}{
+		{"Previous step within skew", previous, true, Step(now) - 1},
+		{"Stale code", stale, false, 0},
+		{"Wrong length", "12345", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(secret, tt.code, now, 1)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantStep, step)
+		})
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("PVZ", "user@mail.ru", rfcSecret)
+
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/PVZ:user@mail.ru?"))
+	assert.Contains(t, uri, "secret="+rfcSecret)
+	assert.Contains(t, uri, "issuer=PVZ")
+}
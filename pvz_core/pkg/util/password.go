@@ -6,8 +6,8 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-func HashPassword(password string) (string, error) {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(password), 8)
+func HashPassword(password string, cost int) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), cost)
 	if err != nil {
 		return "", fmt.Errorf("failed to hash password %w", err)
 	}
@@ -18,3 +18,8 @@ func HashPassword(password string) (string, error) {
 func CheckPassword(password string, hashedPassword string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 }
+
+// PasswordCost returns the bcrypt cost the password was hashed with.
+func PasswordCost(hashedPassword string) (int, error) {
+	return bcrypt.Cost([]byte(hashedPassword))
+}
@@ -19,7 +19,7 @@ func TestHashPassword(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			hashed, err := HashPassword(tt.password)
+			hashed, err := HashPassword(tt.password, bcrypt.MinCost)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("HashPassword() error = %v, wantErr %v", err, tt.wantErr)
@@ -30,6 +30,11 @@ func TestHashPassword(t *testing.T) {
 				if err != nil {
 					t.Errorf("Hashed password does not match original: %v", err)
 				}
+
+				cost, err := PasswordCost(hashed)
+				if err != nil || cost != bcrypt.MinCost {
+					t.Errorf("PasswordCost() = %d, %v, want %d", cost, err, bcrypt.MinCost)
+				}
 			}
 		})
 	}
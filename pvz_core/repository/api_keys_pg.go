@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyTouchInterval limits how often last_used_at is written for a key
+// that is used on every request of an integration.
+const apiKeyTouchInterval = time.Minute
+
+var apiKeyColumns = []string{
+	"id", "name", "key_prefix AS prefix", "user_id", "permissions", "pvz_id",
+	"expires_at", "last_used_at", "created_by", "created_at", "revoked_at",
+}
+
+// APIKeys keeps the keys integrations authenticate with. Only a digest of
+// the key is stored, the prefix is kept to tell keys apart in the list.
+type APIKeys interface {
+	CreateAPIKey(ctx context.Context, req models.APIKeyReq) (models.APIKey, error)
+	GetAPIKeys(ctx context.Context) ([]models.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, keyId uuid.UUID) error
+	TouchAPIKey(ctx context.Context, keyId uuid.UUID) error
+	CanAccessPVZ(ctx context.Context, userId, pvzId uuid.UUID) (bool, error)
+}
+
+type APIKeysRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newAPIKeysRepository(db db.Client, log zerolog.Logger) *APIKeysRepo {
+	return &APIKeysRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (aks *APIKeysRepo) CreateAPIKey(ctx context.Context, req models.APIKeyReq) (models.APIKey, error) {
+	var res models.APIKey
+
+	builder := squirrel.Insert("api_keys").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("id", "name", "key_prefix", "key_hash", "user_id", "permissions", "pvz_id", "expires_at", "created_by").
+		Values(req.Id, req.Name, req.Prefix, req.KeyHash, req.UserId, req.Permissions, req.PvzId, req.ExpiresAt, req.CreatedBy).
+		Suffix("RETURNING " + strings.Join(apiKeyColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("CreateAPIKey: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "api_keys_repository.CreateAPIKey",
+		QueryRow: query,
+	}
+
+	err = aks.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(apiKeyFields(&res)...)
+	if isPgError(err, pgForeignKeyViolation) {
+		return res, status.Errorf(codes.FailedPrecondition, "pvz not found")
+	} else if err != nil {
+		aks.log.Error().Err(err).Msg("CreateAPIKey: failed to execute query")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (aks *APIKeysRepo) GetAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	var res []models.APIKey
+
+	builder := squirrel.Select(apiKeyColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("api_keys").
+		OrderBy("created_at DESC", "id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("GetAPIKeys: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "api_keys_repository.GetAPIKeys",
+		QueryRow: query,
+	}
+
+	err = aks.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		aks.log.Error().Err(err).Msg("GetAPIKeys: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (aks *APIKeysRepo) GetAPIKeyByHash(ctx context.Context, keyHash string) (models.APIKey, error) {
+	var res models.APIKey
+
+	builder := squirrel.Select(apiKeyColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("api_keys").
+		Where(squirrel.Eq{"key_hash": keyHash})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("GetAPIKeyByHash: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "api_keys_repository.GetAPIKeyByHash",
+		QueryRow: query,
+	}
+
+	err = aks.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(apiKeyFields(&res)...)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "API key not found")
+	} else if err != nil {
+		aks.log.Error().Err(err).Msg("GetAPIKeyByHash: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (aks *APIKeysRepo) RevokeAPIKey(ctx context.Context, keyId uuid.UUID) error {
+	builder := squirrel.Update("api_keys").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("revoked_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": keyId, "revoked_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("RevokeAPIKey: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "api_keys_repository.RevokeAPIKey",
+		QueryRow: query,
+	}
+
+	tag, err := aks.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		aks.log.Error().Err(err).Msg("RevokeAPIKey: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "API key not found")
+	}
+
+	return nil
+}
+
+func (aks *APIKeysRepo) TouchAPIKey(ctx context.Context, keyId uuid.UUID) error {
+	builder := squirrel.Update("api_keys").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("last_used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": keyId}).
+		Where(squirrel.Or{
+			squirrel.Eq{"last_used_at": nil},
+			squirrel.Lt{"last_used_at": time.Now().Add(-apiKeyTouchInterval)},
+		})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("TouchAPIKey: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "api_keys_repository.TouchAPIKey",
+		QueryRow: query,
+	}
+
+	_, err = aks.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		aks.log.Error().Err(err).Msg("TouchAPIKey: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+// CanAccessPVZ reports whether the user is the service user of an active API
+// key that is not restricted to another PVZ.
+func (aks *APIKeysRepo) CanAccessPVZ(ctx context.Context, userId, pvzId uuid.UUID) (bool, error) {
+	var allowed bool
+
+	builder := squirrel.Select("1").
+		From("api_keys").
+		Where(squirrel.Eq{"user_id": userId, "revoked_at": nil}).
+		Where(squirrel.Or{
+			squirrel.Eq{"pvz_id": nil},
+			squirrel.Eq{"pvz_id": pvzId},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("CanAccessPVZ: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "api_keys_repository.CanAccessPVZ",
+		QueryRow: query,
+	}
+
+	err = aks.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&allowed)
+	if err != nil {
+		aks.log.Error().Err(err).Msg("CanAccessPVZ: failed to execute query")
+		return false, err
+	}
+
+	return allowed, nil
+}
+
+func apiKeyFields(key *models.APIKey) []interface{} {
+	return []interface{}{
+		&key.Id, &key.Name, &key.Prefix, &key.UserId, &key.Permissions, &key.PvzId,
+		&key.ExpiresAt, &key.LastUsedAt, &key.CreatedBy, &key.CreatedAt, &key.RevokedAt,
+	}
+}
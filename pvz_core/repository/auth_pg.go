@@ -0,0 +1,444 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Authorization interface {
+	CreateUser(ctx context.Context, user models.CreateUserReq) (models.CreateUserRes, error)
+	LoginUser(ctx context.Context, req models.LoginUserReq) (models.LoginUserRes, error)
+	GetUserCredentials(ctx context.Context, userId uuid.UUID) (models.LoginUserRes, error)
+	UpdatePassword(ctx context.Context, userId uuid.UUID, passwordHash string) error
+	GetUserById(ctx context.Context, userId uuid.UUID) (models.User, error)
+	GetUsers(ctx context.Context, req models.GetUsersReq, limit, offset int) ([]models.User, error)
+	CountUsers(ctx context.Context, req models.GetUsersReq) (int, error)
+	UpdateUser(ctx context.Context, user models.User) (models.User, error)
+	DeleteUser(ctx context.Context, userId uuid.UUID) error
+	IsUserTokenRevoked(ctx context.Context, userId uuid.UUID, issuedAt time.Time) (bool, error)
+	RevokeUserTokens(ctx context.Context, userId uuid.UUID) error
+	SetEmailVerified(ctx context.Context, userId uuid.UUID) error
+}
+
+type AuthRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newAuthRepository(db db.Client, log zerolog.Logger) *AuthRepo {
+	return &AuthRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+var userColumns = []string{"id", "email", "role", "city", "disabled", "created_at", "totp_enabled AS two_factor_enabled", "email_verified"}
+
+func (arp *AuthRepo) CreateUser(ctx context.Context, user models.CreateUserReq) (models.CreateUserRes, error) {
+	var res models.CreateUserRes
+
+	builder := squirrel.Insert("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("id", "email", "password_hash", "role", "city").
+		Values(user.Id, user.Email, user.Password, user.Role, user.City).
+		Suffix("RETURNING id, email, role, city")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("CreateUser: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.CreateUser",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Email, &res.Role, &res.City)
+	if isPgError(err, pgForeignKeyViolation) {
+		return res, status.Errorf(codes.FailedPrecondition, "role or city not found")
+	} else if err != nil {
+		arp.log.Error().Err(err).Msg("CreateUser: failed to execute query")
+		return res, status.Errorf(codes.Internal, "failed to create user: %v", err)
+	}
+
+	return res, nil
+}
+
+func (arp *AuthRepo) LoginUser(ctx context.Context, req models.LoginUserReq) (models.LoginUserRes, error) {
+	var res models.LoginUserRes
+
+	builder := squirrel.Select("id", "email", "password_hash", "role", "disabled", "totp_enabled", "email_verified").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"email": req.Email, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("GetUser: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.LoginUser",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Email, &res.Password_hash, &res.Role, &res.Disabled, &res.TwoFactorEnabled, &res.EmailVerified)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		arp.log.Warn().Str("email", req.Email).Msg("LoginUser: user not found")
+
+		return res, status.Errorf(codes.NotFound, "User not found")
+	} else if err != nil {
+		arp.log.Error().Err(err).Msg("LoginUser: failed to execute query")
+
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (arp *AuthRepo) GetUserCredentials(ctx context.Context, userId uuid.UUID) (models.LoginUserRes, error) {
+	var res models.LoginUserRes
+
+	builder := squirrel.Select("id", "email", "password_hash", "role", "disabled", "totp_enabled", "email_verified").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("GetUserCredentials: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.GetUserCredentials",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Email, &res.Password_hash, &res.Role, &res.Disabled, &res.TwoFactorEnabled, &res.EmailVerified)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "User not found")
+	} else if err != nil {
+		arp.log.Error().Err(err).Msg("GetUserCredentials: failed to execute query")
+
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (arp *AuthRepo) UpdatePassword(ctx context.Context, userId uuid.UUID, passwordHash string) error {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("password_hash", passwordHash).
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("UpdatePassword: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.UpdatePassword",
+		QueryRow: query,
+	}
+
+	tag, err := arp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		arp.log.Error().Err(err).Msg("UpdatePassword: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "User not found")
+	}
+
+	return nil
+}
+
+func (arp *AuthRepo) GetUserById(ctx context.Context, userId uuid.UUID) (models.User, error) {
+	var res models.User
+
+	builder := squirrel.Select(userColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("GetUserById: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.GetUserById",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Email, &res.Role, &res.City, &res.Disabled, &res.CreatedAt, &res.TwoFactorEnabled, &res.EmailVerified)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		arp.log.Warn().Str("id", userId.String()).Msg("GetUserById: user not found")
+
+		return res, status.Errorf(codes.NotFound, "User not found")
+	} else if err != nil {
+		arp.log.Error().Err(err).Msg("GetUserById: failed to execute query")
+
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (arp *AuthRepo) GetUsers(ctx context.Context, req models.GetUsersReq, limit, offset int) ([]models.User, error) {
+	var res []models.User
+
+	builder := squirrel.Select(userColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(usersCond(req)).
+		OrderBy("created_at", "id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("GetUsers: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.GetUsers",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		arp.log.Error().Err(err).Msg("GetUsers: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (arp *AuthRepo) CountUsers(ctx context.Context, req models.GetUsersReq) (int, error) {
+	var total int
+
+	builder := squirrel.Select("COUNT(*)").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(usersCond(req))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("CountUsers: failed to build SQL query")
+		return 0, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.CountUsers",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&total)
+	if err != nil {
+		arp.log.Error().Err(err).Msg("CountUsers: failed to execute query")
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func usersCond(req models.GetUsersReq) squirrel.And {
+	cond := squirrel.And{squirrel.Eq{"deleted_at": nil}}
+
+	if req.Role != "" {
+		cond = append(cond, squirrel.Eq{"role": req.Role})
+	}
+
+	if req.Disabled != nil {
+		cond = append(cond, squirrel.Eq{"disabled": *req.Disabled})
+	}
+
+	return cond
+}
+
+func (arp *AuthRepo) UpdateUser(ctx context.Context, user models.User) (models.User, error) {
+	var res models.User
+
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("role", user.Role).
+		Set("city", user.City).
+		Set("disabled", user.Disabled).
+		Where(squirrel.Eq{"id": user.Id, "deleted_at": nil}).
+		Suffix("RETURNING " + strings.Join(userColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("UpdateUser: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.UpdateUser",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Email, &res.Role, &res.City, &res.Disabled, &res.CreatedAt, &res.TwoFactorEnabled, &res.EmailVerified)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "User not found")
+	} else if isPgError(err, pgForeignKeyViolation) {
+		return res, status.Errorf(codes.FailedPrecondition, "role or city not found")
+	} else if err != nil {
+		arp.log.Error().Err(err).Msg("UpdateUser: failed to execute query")
+		return res, err
+	}
+
+	return res, nil
+}
+
+// DeleteUser disables the account and hides it from the API. The row is kept
+// because PVZ and receptions reference the users who created them.
+func (arp *AuthRepo) DeleteUser(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("disabled", true).
+		Set("deleted_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("DeleteUser: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.DeleteUser",
+		QueryRow: query,
+	}
+
+	tag, err := arp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		arp.log.Error().Err(err).Msg("DeleteUser: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "User not found")
+	}
+
+	return nil
+}
+
+// IsUserTokenRevoked implements token.UserStatus. Users missing from the
+// table, like the dummy login ones, have no revoked tokens. The issue time of
+// a token has a precision of a second, so tokens_valid_after is stored
+// truncated to a second as well.
+func (arp *AuthRepo) IsUserTokenRevoked(ctx context.Context, userId uuid.UUID, issuedAt time.Time) (bool, error) {
+	var revoked bool
+
+	builder := squirrel.Select("1").
+		Prefix("SELECT EXISTS (").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"id": userId}).
+		Where(squirrel.Or{
+			squirrel.Eq{"disabled": true},
+			squirrel.Expr("tokens_valid_after > to_timestamp(?)", issuedAt.Unix()),
+		}).
+		Suffix(")")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("IsUserTokenRevoked: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.IsUserTokenRevoked",
+		QueryRow: query,
+	}
+
+	err = arp.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&revoked)
+	if err != nil {
+		arp.log.Error().Err(err).Msg("IsUserTokenRevoked: failed to execute query")
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// RevokeUserTokens makes access tokens issued to the user so far invalid.
+func (arp *AuthRepo) RevokeUserTokens(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("tokens_valid_after", squirrel.Expr("date_trunc('second', CURRENT_TIMESTAMP)")).
+		Where(squirrel.Eq{"id": userId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("RevokeUserTokens: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.RevokeUserTokens",
+		QueryRow: query,
+	}
+
+	_, err = arp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		arp.log.Error().Err(err).Msg("RevokeUserTokens: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (arp *AuthRepo) SetEmailVerified(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("email_verified", true).
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		arp.log.Error().Err(err).Msg("SetEmailVerified: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "auth_repository.SetEmailVerified",
+		QueryRow: query,
+	}
+
+	tag, err := arp.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		arp.log.Error().Err(err).Msg("SetEmailVerified: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "User not found")
+	}
+
+	return nil
+}
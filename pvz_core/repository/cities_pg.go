@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Cities interface {
+	CreateCity(ctx context.Context, req models.CreateCityReq) (models.City, error)
+	GetCities(ctx context.Context) ([]models.City, error)
+	GetCityByName(ctx context.Context, name string) (models.City, error)
+	UpdateCity(ctx context.Context, cityId uuid.UUID, req models.UpdateCityReq) (models.City, error)
+	DeleteCity(ctx context.Context, cityId uuid.UUID) error
+}
+
+type CitiesRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newCitiesRepository(db db.Client, log zerolog.Logger) *CitiesRepo {
+	return &CitiesRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+var cityColumns = []string{"id", "name", "is_active", "launch_date", "created_at"}
+
+func (ct *CitiesRepo) CreateCity(ctx context.Context, req models.CreateCityReq) (models.City, error) {
+	var res models.City
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	builder := squirrel.Insert("cities").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("name", "is_active", "launch_date").
+		Values(req.Name, isActive, req.LaunchDate).
+		Suffix("RETURNING " + strings.Join(cityColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ct.log.Error().Err(err).Msg("CreateCity: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "cities_repository.CreateCity",
+		QueryRow: query,
+	}
+
+	err = ct.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Name, &res.IsActive, &res.LaunchDate, &res.CreatedAt)
+	if isPgError(err, pgUniqueViolation) {
+		return res, status.Errorf(codes.AlreadyExists, "City already exists")
+	} else if err != nil {
+		ct.log.Error().Err(err).Msg("CreateCity: failed to execute query")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (ct *CitiesRepo) GetCities(ctx context.Context) ([]models.City, error) {
+	var res []models.City
+
+	builder := squirrel.Select(cityColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("cities").
+		OrderBy("name")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ct.log.Error().Err(err).Msg("GetCities: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "cities_repository.GetCities",
+		QueryRow: query,
+	}
+
+	err = ct.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		ct.log.Error().Err(err).Msg("GetCities: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ct *CitiesRepo) GetCityByName(ctx context.Context, name string) (models.City, error) {
+	var res models.City
+
+	builder := squirrel.Select(cityColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("cities").
+		Where(squirrel.Eq{"name": name})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ct.log.Error().Err(err).Msg("GetCityByName: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "cities_repository.GetCityByName",
+		QueryRow: query,
+	}
+
+	err = ct.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Name, &res.IsActive, &res.LaunchDate, &res.CreatedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "City not found")
+	} else if err != nil {
+		ct.log.Error().Err(err).Msg("GetCityByName: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (ct *CitiesRepo) UpdateCity(ctx context.Context, cityId uuid.UUID, req models.UpdateCityReq) (models.City, error) {
+	var res models.City
+
+	builder := squirrel.Update("cities").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"id": cityId}).
+		Suffix("RETURNING " + strings.Join(cityColumns, ", "))
+
+	if req.IsActive != nil {
+		builder = builder.Set("is_active", *req.IsActive)
+	}
+
+	if req.LaunchDate != nil {
+		builder = builder.Set("launch_date", *req.LaunchDate)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ct.log.Error().Err(err).Msg("UpdateCity: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "cities_repository.UpdateCity",
+		QueryRow: query,
+	}
+
+	err = ct.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.Name, &res.IsActive, &res.LaunchDate, &res.CreatedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "City not found")
+	} else if err != nil {
+		ct.log.Error().Err(err).Msg("UpdateCity: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (ct *CitiesRepo) DeleteCity(ctx context.Context, cityId uuid.UUID) error {
+	builder := squirrel.Delete("cities").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"id": cityId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ct.log.Error().Err(err).Msg("DeleteCity: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "cities_repository.DeleteCity",
+		QueryRow: query,
+	}
+
+	tag, err := ct.db.DB().ExecContext(ctx, queryStruct, args...)
+	if isPgError(err, pgForeignKeyViolation) {
+		return status.Errorf(codes.FailedPrecondition, "City has PVZ")
+	} else if err != nil {
+		ct.log.Error().Err(err).Msg("DeleteCity: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "City not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EmailVerifications keeps one-time email verification tokens, stored as a
+// digest like password reset tokens.
+type EmailVerifications interface {
+	CreateEmailVerificationToken(ctx context.Context, req models.EmailVerificationTokenReq) error
+	GetEmailVerificationToken(ctx context.Context, tokenHash string) (models.EmailVerificationTokenRes, error)
+	UseEmailVerificationToken(ctx context.Context, tokenId uuid.UUID) (bool, error)
+	UseUserEmailVerificationTokens(ctx context.Context, userId uuid.UUID) error
+	DeleteExpiredEmailVerificationTokens(ctx context.Context) error
+}
+
+type EmailVerificationsRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newEmailVerificationsRepository(db db.Client, log zerolog.Logger) *EmailVerificationsRepo {
+	return &EmailVerificationsRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (evr *EmailVerificationsRepo) CreateEmailVerificationToken(ctx context.Context,
+	req models.EmailVerificationTokenReq) error {
+	builder := squirrel.Insert("email_verification_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "token_hash", "expires_at").
+		Values(req.UserId, req.TokenHash, req.ExpiresAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		evr.log.Error().Err(err).Msg("CreateEmailVerificationToken: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "email_verifications_repository.CreateEmailVerificationToken",
+		QueryRow: query,
+	}
+
+	_, err = evr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		evr.log.Error().Err(err).Msg("CreateEmailVerificationToken: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (evr *EmailVerificationsRepo) GetEmailVerificationToken(ctx context.Context,
+	tokenHash string) (models.EmailVerificationTokenRes, error) {
+	var res models.EmailVerificationTokenRes
+
+	builder := squirrel.Select("id", "user_id", "expires_at", "used_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("email_verification_tokens").
+		Where(squirrel.Eq{"token_hash": tokenHash})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		evr.log.Error().Err(err).Msg("GetEmailVerificationToken: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "email_verifications_repository.GetEmailVerificationToken",
+		QueryRow: query,
+	}
+
+	err = evr.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.UserId, &res.ExpiresAt, &res.UsedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Email verification token not found")
+	} else if err != nil {
+		evr.log.Error().Err(err).Msg("GetEmailVerificationToken: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+// UseEmailVerificationToken marks the token as used and reports false if it
+// already was.
+func (evr *EmailVerificationsRepo) UseEmailVerificationToken(ctx context.Context, tokenId uuid.UUID) (bool, error) {
+	builder := squirrel.Update("email_verification_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": tokenId, "used_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		evr.log.Error().Err(err).Msg("UseEmailVerificationToken: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "email_verifications_repository.UseEmailVerificationToken",
+		QueryRow: query,
+	}
+
+	tag, err := evr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		evr.log.Error().Err(err).Msg("UseEmailVerificationToken: failed to execute query")
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (evr *EmailVerificationsRepo) UseUserEmailVerificationTokens(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("email_verification_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"user_id": userId, "used_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		evr.log.Error().Err(err).Msg("UseUserEmailVerificationTokens: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "email_verifications_repository.UseUserEmailVerificationTokens",
+		QueryRow: query,
+	}
+
+	_, err = evr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		evr.log.Error().Err(err).Msg("UseUserEmailVerificationTokens: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (evr *EmailVerificationsRepo) DeleteExpiredEmailVerificationTokens(ctx context.Context) error {
+	builder := squirrel.Delete("email_verification_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Expr("expires_at < CURRENT_TIMESTAMP"))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		evr.log.Error().Err(err).Msg("DeleteExpiredEmailVerificationTokens: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "email_verifications_repository.DeleteExpiredEmailVerificationTokens",
+		QueryRow: query,
+	}
+
+	_, err = evr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		evr.log.Error().Err(err).Msg("DeleteExpiredEmailVerificationTokens: failed to execute query")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var inviteColumns = []string{
+	"id", "email", "role", "city", "created_by", "expires_at",
+	"used_at", "used_by", "revoked_at", "created_at",
+}
+
+// Invites keeps registration invites. As with other one-time tokens only a
+// digest of the invite token is stored.
+type Invites interface {
+	CreateInvite(ctx context.Context, req models.InviteReq) (models.Invite, error)
+	GetInvites(ctx context.Context) ([]models.Invite, error)
+	GetInviteByHash(ctx context.Context, tokenHash string) (models.Invite, error)
+	UseInvite(ctx context.Context, inviteId, userId uuid.UUID) (bool, error)
+	RevokeInvite(ctx context.Context, inviteId uuid.UUID) error
+}
+
+type InvitesRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newInvitesRepository(db db.Client, log zerolog.Logger) *InvitesRepo {
+	return &InvitesRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (ivr *InvitesRepo) CreateInvite(ctx context.Context, req models.InviteReq) (models.Invite, error) {
+	var res models.Invite
+
+	builder := squirrel.Insert("invites").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("email", "role", "city", "token_hash", "created_by", "expires_at").
+		Values(req.Email, req.Role, req.City, req.TokenHash, req.CreatedBy, req.ExpiresAt).
+		Suffix("RETURNING " + strings.Join(inviteColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("CreateInvite: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "invites_repository.CreateInvite",
+		QueryRow: query,
+	}
+
+	err = ivr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(inviteFields(&res)...)
+	if isPgError(err, pgForeignKeyViolation) {
+		return res, status.Errorf(codes.FailedPrecondition, "role or city not found")
+	} else if err != nil {
+		ivr.log.Error().Err(err).Msg("CreateInvite: failed to execute query")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (ivr *InvitesRepo) GetInvites(ctx context.Context) ([]models.Invite, error) {
+	var res []models.Invite
+
+	builder := squirrel.Select(inviteColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("invites").
+		OrderBy("created_at DESC", "id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("GetInvites: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "invites_repository.GetInvites",
+		QueryRow: query,
+	}
+
+	err = ivr.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("GetInvites: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ivr *InvitesRepo) GetInviteByHash(ctx context.Context, tokenHash string) (models.Invite, error) {
+	var res models.Invite
+
+	builder := squirrel.Select(inviteColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("invites").
+		Where(squirrel.Eq{"token_hash": tokenHash})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("GetInviteByHash: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "invites_repository.GetInviteByHash",
+		QueryRow: query,
+	}
+
+	err = ivr.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(inviteFields(&res)...)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Invite not found")
+	} else if err != nil {
+		ivr.log.Error().Err(err).Msg("GetInviteByHash: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+// UseInvite marks an active invite as used by the registered user and
+// reports false if it was already used, revoked or has expired.
+func (ivr *InvitesRepo) UseInvite(ctx context.Context, inviteId, userId uuid.UUID) (bool, error) {
+	builder := squirrel.Update("invites").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Set("used_by", userId).
+		Where(squirrel.Eq{"id": inviteId, "used_at": nil, "revoked_at": nil}).
+		Where(squirrel.Expr("expires_at > CURRENT_TIMESTAMP"))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("UseInvite: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "invites_repository.UseInvite",
+		QueryRow: query,
+	}
+
+	tag, err := ivr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("UseInvite: failed to execute query")
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// RevokeInvite revokes an invite that has not been used yet.
+func (ivr *InvitesRepo) RevokeInvite(ctx context.Context, inviteId uuid.UUID) error {
+	builder := squirrel.Update("invites").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("revoked_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": inviteId, "used_at": nil, "revoked_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("RevokeInvite: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "invites_repository.RevokeInvite",
+		QueryRow: query,
+	}
+
+	tag, err := ivr.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		ivr.log.Error().Err(err).Msg("RevokeInvite: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "Invite not found")
+	}
+
+	return nil
+}
+
+func inviteFields(invite *models.Invite) []interface{} {
+	return []interface{}{
+		&invite.Id, &invite.Email, &invite.Role, &invite.City, &invite.CreatedBy, &invite.ExpiresAt,
+		&invite.UsedAt, &invite.UsedBy, &invite.RevokedAt, &invite.CreatedAt,
+	}
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+// LoginAttempts keeps failed login counters per key, e.g. per account or per
+// client IP, so that repeated failures lock the key out for a while.
+type LoginAttempts interface {
+	GetLockedUntil(ctx context.Context, keys []string) (*time.Time, error)
+	RegisterLoginFailure(ctx context.Context, key string, now time.Time, window time.Duration) (int, error)
+	LockLogin(ctx context.Context, key string, until time.Time) error
+	ResetLoginFailures(ctx context.Context, key string) error
+	DeleteStaleLoginAttempts(ctx context.Context, before time.Time) error
+}
+
+type LoginAttemptsRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newLoginAttemptsRepository(db db.Client, log zerolog.Logger) *LoginAttemptsRepo {
+	return &LoginAttemptsRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+// GetLockedUntil returns the latest lock among the keys, or nil if none is locked.
+func (lat *LoginAttemptsRepo) GetLockedUntil(ctx context.Context, keys []string) (*time.Time, error) {
+	var lockedUntil *time.Time
+
+	builder := squirrel.Select("MAX(locked_until)").
+		PlaceholderFormat(squirrel.Dollar).
+		From("login_attempts").
+		Where(squirrel.Eq{"key": keys})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		lat.log.Error().Err(err).Msg("GetLockedUntil: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "login_attempts_repository.GetLockedUntil",
+		QueryRow: query,
+	}
+
+	err = lat.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&lockedUntil)
+	if err != nil {
+		lat.log.Error().Err(err).Msg("GetLockedUntil: failed to execute query")
+		return nil, err
+	}
+
+	return lockedUntil, nil
+}
+
+// RegisterLoginFailure increments the counter of the key and returns it. The
+// counter starts over when the previous failure is older than the window.
+func (lat *LoginAttemptsRepo) RegisterLoginFailure(ctx context.Context, key string, now time.Time,
+	window time.Duration) (int, error) {
+	var failures int
+
+	builder := squirrel.Insert("login_attempts").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("key", "failures", "last_failure_at").
+		Values(key, 1, now).
+		Suffix(`ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN login_attempts.last_failure_at < ? THEN 1 ELSE login_attempts.failures + 1 END,
+			last_failure_at = EXCLUDED.last_failure_at
+			RETURNING failures`, now.Add(-window))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		lat.log.Error().Err(err).Msg("RegisterLoginFailure: failed to build SQL query")
+		return 0, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "login_attempts_repository.RegisterLoginFailure",
+		QueryRow: query,
+	}
+
+	err = lat.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&failures)
+	if err != nil {
+		lat.log.Error().Err(err).Msg("RegisterLoginFailure: failed to execute query")
+		return 0, err
+	}
+
+	return failures, nil
+}
+
+func (lat *LoginAttemptsRepo) LockLogin(ctx context.Context, key string, until time.Time) error {
+	builder := squirrel.Update("login_attempts").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("locked_until", until).
+		Where(squirrel.Eq{"key": key})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		lat.log.Error().Err(err).Msg("LockLogin: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "login_attempts_repository.LockLogin",
+		QueryRow: query,
+	}
+
+	_, err = lat.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		lat.log.Error().Err(err).Msg("LockLogin: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (lat *LoginAttemptsRepo) ResetLoginFailures(ctx context.Context, key string) error {
+	builder := squirrel.Delete("login_attempts").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"key": key})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		lat.log.Error().Err(err).Msg("ResetLoginFailures: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "login_attempts_repository.ResetLoginFailures",
+		QueryRow: query,
+	}
+
+	_, err = lat.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		lat.log.Error().Err(err).Msg("ResetLoginFailures: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (lat *LoginAttemptsRepo) DeleteStaleLoginAttempts(ctx context.Context, before time.Time) error {
+	builder := squirrel.Delete("login_attempts").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Lt{"last_failure_at": before}).
+		Where(squirrel.Or{
+			squirrel.Eq{"locked_until": nil},
+			squirrel.Lt{"locked_until": before},
+		})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		lat.log.Error().Err(err).Msg("DeleteStaleLoginAttempts: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "login_attempts_repository.DeleteStaleLoginAttempts",
+		QueryRow: query,
+	}
+
+	_, err = lat.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		lat.log.Error().Err(err).Msg("DeleteStaleLoginAttempts: failed to execute query")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PasswordResets keeps one-time password reset tokens. As with refresh tokens
+// only a digest of the token is stored.
+type PasswordResets interface {
+	CreatePasswordResetToken(ctx context.Context, req models.PasswordResetTokenReq) error
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (models.PasswordResetTokenRes, error)
+	UsePasswordResetToken(ctx context.Context, tokenId uuid.UUID) (bool, error)
+	UseUserPasswordResetTokens(ctx context.Context, userId uuid.UUID) error
+	DeleteExpiredPasswordResetTokens(ctx context.Context) error
+}
+
+type PasswordResetsRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newPasswordResetsRepository(db db.Client, log zerolog.Logger) *PasswordResetsRepo {
+	return &PasswordResetsRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (prs *PasswordResetsRepo) CreatePasswordResetToken(ctx context.Context, req models.PasswordResetTokenReq) error {
+	builder := squirrel.Insert("password_reset_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "token_hash", "expires_at").
+		Values(req.UserId, req.TokenHash, req.ExpiresAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prs.log.Error().Err(err).Msg("CreatePasswordResetToken: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "password_resets_repository.CreatePasswordResetToken",
+		QueryRow: query,
+	}
+
+	_, err = prs.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		prs.log.Error().Err(err).Msg("CreatePasswordResetToken: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (prs *PasswordResetsRepo) GetPasswordResetToken(ctx context.Context,
+	tokenHash string) (models.PasswordResetTokenRes, error) {
+	var res models.PasswordResetTokenRes
+
+	builder := squirrel.Select("id", "user_id", "expires_at", "used_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("password_reset_tokens").
+		Where(squirrel.Eq{"token_hash": tokenHash})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prs.log.Error().Err(err).Msg("GetPasswordResetToken: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "password_resets_repository.GetPasswordResetToken",
+		QueryRow: query,
+	}
+
+	err = prs.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.UserId, &res.ExpiresAt, &res.UsedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Password reset token not found")
+	} else if err != nil {
+		prs.log.Error().Err(err).Msg("GetPasswordResetToken: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+// UsePasswordResetToken marks the token as used and reports false if it
+// already was, so a token can not be redeemed twice concurrently.
+func (prs *PasswordResetsRepo) UsePasswordResetToken(ctx context.Context, tokenId uuid.UUID) (bool, error) {
+	builder := squirrel.Update("password_reset_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": tokenId, "used_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prs.log.Error().Err(err).Msg("UsePasswordResetToken: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "password_resets_repository.UsePasswordResetToken",
+		QueryRow: query,
+	}
+
+	tag, err := prs.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		prs.log.Error().Err(err).Msg("UsePasswordResetToken: failed to execute query")
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (prs *PasswordResetsRepo) UseUserPasswordResetTokens(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("password_reset_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"user_id": userId, "used_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prs.log.Error().Err(err).Msg("UseUserPasswordResetTokens: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "password_resets_repository.UseUserPasswordResetTokens",
+		QueryRow: query,
+	}
+
+	_, err = prs.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		prs.log.Error().Err(err).Msg("UseUserPasswordResetTokens: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (prs *PasswordResetsRepo) DeleteExpiredPasswordResetTokens(ctx context.Context) error {
+	builder := squirrel.Delete("password_reset_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Expr("expires_at < CURRENT_TIMESTAMP"))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prs.log.Error().Err(err).Msg("DeleteExpiredPasswordResetTokens: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "password_resets_repository.DeleteExpiredPasswordResetTokens",
+		QueryRow: query,
+	}
+
+	_, err = prs.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		prs.log.Error().Err(err).Msg("DeleteExpiredPasswordResetTokens: failed to execute query")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ProductTypes interface {
+	CreateProductType(ctx context.Context, req models.CreateProductTypeReq) (models.ProductType, error)
+	GetProductTypes(ctx context.Context) ([]models.ProductType, error)
+	GetProductTypeByCode(ctx context.Context, code string) (models.ProductType, error)
+	UpdateProductType(ctx context.Context, code string, req models.UpdateProductTypeReq) (models.ProductType, error)
+	DeleteProductType(ctx context.Context, code string) error
+}
+
+type ProductTypesRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newProductTypesRepository(db db.Client, log zerolog.Logger) *ProductTypesRepo {
+	return &ProductTypesRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+var productTypeColumns = []string{"code", "display_name", "fragile", "oversized", "is_active", "created_at"}
+
+func (pt *ProductTypesRepo) CreateProductType(ctx context.Context, req models.CreateProductTypeReq) (models.ProductType, error) {
+	var res models.ProductType
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	builder := squirrel.Insert("product_types").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("code", "display_name", "fragile", "oversized", "is_active").
+		Values(req.Code, req.DisplayName, req.Fragile, req.Oversized, isActive).
+		Suffix("RETURNING " + strings.Join(productTypeColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pt.log.Error().Err(err).Msg("CreateProductType: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "product_types_repository.CreateProductType",
+		QueryRow: query,
+	}
+
+	err = pt.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Code, &res.DisplayName, &res.Fragile, &res.Oversized, &res.IsActive, &res.CreatedAt)
+	if isPgError(err, pgUniqueViolation) {
+		return res, status.Errorf(codes.AlreadyExists, "Product type already exists")
+	} else if err != nil {
+		pt.log.Error().Err(err).Msg("CreateProductType: failed to execute query")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (pt *ProductTypesRepo) GetProductTypes(ctx context.Context) ([]models.ProductType, error) {
+	var res []models.ProductType
+
+	builder := squirrel.Select(productTypeColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("product_types").
+		OrderBy("display_name")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pt.log.Error().Err(err).Msg("GetProductTypes: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "product_types_repository.GetProductTypes",
+		QueryRow: query,
+	}
+
+	err = pt.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		pt.log.Error().Err(err).Msg("GetProductTypes: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (pt *ProductTypesRepo) GetProductTypeByCode(ctx context.Context, code string) (models.ProductType, error) {
+	var res models.ProductType
+
+	builder := squirrel.Select(productTypeColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("product_types").
+		Where(squirrel.Eq{"code": code})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pt.log.Error().Err(err).Msg("GetProductTypeByCode: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "product_types_repository.GetProductTypeByCode",
+		QueryRow: query,
+	}
+
+	err = pt.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Code, &res.DisplayName, &res.Fragile, &res.Oversized, &res.IsActive, &res.CreatedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Product type not found")
+	} else if err != nil {
+		pt.log.Error().Err(err).Msg("GetProductTypeByCode: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (pt *ProductTypesRepo) UpdateProductType(ctx context.Context, code string, req models.UpdateProductTypeReq) (
+	models.ProductType, error) {
+	var res models.ProductType
+
+	builder := squirrel.Update("product_types").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"code": code}).
+		Suffix("RETURNING " + strings.Join(productTypeColumns, ", "))
+
+	if req.DisplayName != nil {
+		builder = builder.Set("display_name", *req.DisplayName)
+	}
+
+	if req.Fragile != nil {
+		builder = builder.Set("fragile", *req.Fragile)
+	}
+
+	if req.Oversized != nil {
+		builder = builder.Set("oversized", *req.Oversized)
+	}
+
+	if req.IsActive != nil {
+		builder = builder.Set("is_active", *req.IsActive)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pt.log.Error().Err(err).Msg("UpdateProductType: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "product_types_repository.UpdateProductType",
+		QueryRow: query,
+	}
+
+	err = pt.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Code, &res.DisplayName, &res.Fragile, &res.Oversized, &res.IsActive, &res.CreatedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Product type not found")
+	} else if err != nil {
+		pt.log.Error().Err(err).Msg("UpdateProductType: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (pt *ProductTypesRepo) DeleteProductType(ctx context.Context, code string) error {
+	builder := squirrel.Delete("product_types").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"code": code})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pt.log.Error().Err(err).Msg("DeleteProductType: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "product_types_repository.DeleteProductType",
+		QueryRow: query,
+	}
+
+	tag, err := pt.db.DB().ExecContext(ctx, queryStruct, args...)
+	if isPgError(err, pgForeignKeyViolation) {
+		return status.Errorf(codes.FailedPrecondition, "Product type is used by products")
+	} else if err != nil {
+		pt.log.Error().Err(err).Msg("DeleteProductType: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "Product type not found")
+	}
+
+	return nil
+}
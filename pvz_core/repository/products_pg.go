@@ -3,8 +3,9 @@ package repository
 import (
 	"context"
 
-	db "github.com/MaksimovDenis/avito_pvz/internal/client"
-	"github.com/MaksimovDenis/avito_pvz/internal/models"
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/cursor"
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -14,6 +15,9 @@ type Products interface {
 	AddProduct(ctx context.Context, req models.CreateProductReq) (models.CreateProductRes, error)
 	GetLastProductIdByReceptionId(ctx context.Context, receptionId uuid.UUID) (uuid.UUID, error)
 	DeleteProduct(ctx context.Context, productId uuid.UUID) error
+	GetProductsByReceptionId(ctx context.Context, receptionId uuid.UUID, after *cursor.Cursor, limit int) (
+		[]models.ProductRes, error)
+	CountProductsByType(ctx context.Context, receptionIds []uuid.UUID) (map[uuid.UUID]map[string]int, error)
 }
 
 type ProductsRepo struct {
@@ -113,3 +117,88 @@ func (prd *ProductsRepo) DeleteProduct(ctx context.Context, productId uuid.UUID)
 
 	return nil
 }
+
+// GetProductsByReceptionId returns the products of the reception in the order
+// they were accepted.
+func (prd *ProductsRepo) GetProductsByReceptionId(ctx context.Context, receptionId uuid.UUID, after *cursor.Cursor,
+	limit int) ([]models.ProductRes, error) {
+	var res []models.ProductRes
+
+	builder := squirrel.Select("id", "product_type", "created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("products").
+		Where(squirrel.Eq{"reception_id": receptionId}).
+		OrderBy("created_at", "id").
+		Limit(uint64(limit))
+
+	if after != nil {
+		builder = builder.Where(squirrel.Expr("(created_at, id) > (?, ?)", after.CreatedAt, after.Id))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prd.log.Error().Err(err).Msg("GetProductsByReceptionId: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "products_repository.GetProductsByReceptionId",
+		QueryRow: query,
+	}
+
+	err = prd.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		prd.log.Error().Err(err).Msg("GetProductsByReceptionId: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (prd *ProductsRepo) CountProductsByType(ctx context.Context, receptionIds []uuid.UUID) (
+	map[uuid.UUID]map[string]int, error) {
+	res := make(map[uuid.UUID]map[string]int, len(receptionIds))
+
+	if len(receptionIds) == 0 {
+		return res, nil
+	}
+
+	var rows []struct {
+		ReceptionId uuid.UUID
+		ProductType string
+		Count       int
+	}
+
+	builder := squirrel.Select("reception_id", "product_type", "COUNT(*) AS count").
+		PlaceholderFormat(squirrel.Dollar).
+		From("products").
+		Where(squirrel.Eq{"reception_id": receptionIds}).
+		GroupBy("reception_id", "product_type")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		prd.log.Error().Err(err).Msg("CountProductsByType: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "products_repository.CountProductsByType",
+		QueryRow: query,
+	}
+
+	err = prd.db.DB().ScanAllContext(ctx, &rows, queryStruct, args...)
+	if err != nil {
+		prd.log.Error().Err(err).Msg("CountProductsByType: failed to scan rows")
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if res[row.ReceptionId] == nil {
+			res[row.ReceptionId] = make(map[string]int)
+		}
+
+		res[row.ReceptionId][row.ProductType] = row.Count
+	}
+
+	return res, nil
+}
@@ -0,0 +1,755 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/cursor"
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PVZ interface {
+	CreatePVZ(ctx context.Context, newPVZ models.PVZReq) (models.PVZRes, error)
+	GetFullPVZInfo(ctx context.Context, params models.GetPVZReq, after *cursor.Cursor, limit, offset int) (
+		[]models.FullPVZRes, error)
+	CountPVZ(ctx context.Context, params models.GetPVZReq) (int, error)
+	GetPVZList(ctx context.Context, filter models.PVZFilter, after *cursor.Cursor, limit int) ([]models.PVZRes, error)
+	StreamFullPVZInfo(ctx context.Context, filter models.PVZFilter, at time.Time,
+		fn func(models.FullPVZRes, models.PVZScheduleOnDate) error) error
+	GetNearbyPVZ(ctx context.Context, req models.NearbyPVZReq) ([]models.NearbyPVZRes, error)
+	GetPVZById(ctx context.Context, pvzId uuid.UUID) (models.PVZRes, error)
+	LockPVZById(ctx context.Context, pvzId uuid.UUID) (models.PVZRes, error)
+	UpdatePVZ(ctx context.Context, pvzId uuid.UUID, req models.UpdatePVZReq) (models.PVZRes, error)
+	DeletePVZ(ctx context.Context, pvzId uuid.UUID) error
+}
+
+type PVZRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newPVZRepository(db db.Client, log zerolog.Logger) *PVZRepo {
+	return &PVZRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+const pvzStatusClosed = "closed"
+
+var pvzColumns = []string{"id", "city", "created_at AS registration_date", "status", "address", "lat", "lon"}
+
+func scanPVZ(row pgx.Row, res *models.PVZRes) error {
+	return row.Scan(&res.Id, &res.City, &res.RegistrationDate, &res.Status, &res.Address, &res.Lat, &res.Lon)
+}
+
+const (
+	earthRadiusMeters     = 6371000
+	metersPerLatitudeDeg  = 111320
+	haversineDistanceExpr = "2 * ? * ASIN(LEAST(1, SQRT(" +
+		"POWER(SIN(RADIANS(lat - ?) / 2), 2) + " +
+		"COS(RADIANS(?)) * COS(RADIANS(lat)) * POWER(SIN(RADIANS(lon - ?) / 2), 2))))"
+)
+
+func (pvz *PVZRepo) CreatePVZ(ctx context.Context, newPVZ models.PVZReq) (models.PVZRes, error) {
+	var res models.PVZRes
+
+	builder := squirrel.Insert("pvz").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "city", "created_at", "address", "lat", "lon").
+		Values(newPVZ.User_id, newPVZ.City, newPVZ.RegistrationDate, newPVZ.Address, newPVZ.Lat, newPVZ.Lon).
+		Suffix("RETURNING " + strings.Join(pvzColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("CreatePVZ: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.CreatePVZ",
+		QueryRow: query,
+	}
+
+	err = scanPVZ(pvz.db.DB().QueryRowContext(ctx, queryStruct, args...), &res)
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("CreatePVZ: failed to execute query")
+		return res, err
+	}
+
+	return res, nil
+
+}
+
+func (pvz *PVZRepo) GetPVZById(ctx context.Context, pvzId uuid.UUID) (models.PVZRes, error) {
+	var res models.PVZRes
+
+	builder := squirrel.Select(pvzColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz").
+		Where(squirrel.Eq{"id": pvzId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetPVZById: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.GetPVZById",
+		QueryRow: query,
+	}
+
+	err = scanPVZ(pvz.db.DB().QueryRowContext(ctx, queryStruct, args...), &res)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "PVZ not found")
+	} else if err != nil {
+		pvz.log.Error().Err(err).Msg("GetPVZById: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+// LockPVZById reads the PVZ and locks its row until the end of the
+// transaction, so that its status and receptions change one at a time. It has
+// to run in a transaction.
+func (pvz *PVZRepo) LockPVZById(ctx context.Context, pvzId uuid.UUID) (models.PVZRes, error) {
+	var res models.PVZRes
+
+	builder := squirrel.Select(pvzColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz").
+		Where(squirrel.Eq{"id": pvzId}).
+		Suffix("FOR UPDATE")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("LockPVZById: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.LockPVZById",
+		QueryRow: query,
+	}
+
+	err = scanPVZ(pvz.db.DB().QueryRowContext(ctx, queryStruct, args...), &res)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "PVZ not found")
+	} else if err != nil {
+		pvz.log.Error().Err(err).Msg("LockPVZById: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (pvz *PVZRepo) UpdatePVZ(ctx context.Context, pvzId uuid.UUID, req models.UpdatePVZReq) (models.PVZRes, error) {
+	var res models.PVZRes
+
+	builder := squirrel.Update("pvz").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"id": pvzId}).
+		Suffix("RETURNING " + strings.Join(pvzColumns, ", "))
+
+	if req.City != nil {
+		builder = builder.Set("city", *req.City)
+	}
+
+	if req.Status != nil {
+		builder = builder.Set("status", *req.Status)
+	}
+
+	if req.Address != nil {
+		builder = builder.Set("address", *req.Address)
+	}
+
+	if req.Lat != nil {
+		builder = builder.Set("lat", *req.Lat)
+	}
+
+	if req.Lon != nil {
+		builder = builder.Set("lon", *req.Lon)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("UpdatePVZ: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.UpdatePVZ",
+		QueryRow: query,
+	}
+
+	err = scanPVZ(pvz.db.DB().QueryRowContext(ctx, queryStruct, args...), &res)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "PVZ not found")
+	} else if err != nil {
+		pvz.log.Error().Err(err).Msg("UpdatePVZ: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (pvz *PVZRepo) DeletePVZ(ctx context.Context, pvzId uuid.UUID) error {
+	builder := squirrel.Delete("pvz").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"id": pvzId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("DeletePVZ: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.DeletePVZ",
+		QueryRow: query,
+	}
+
+	tag, err := pvz.db.DB().ExecContext(ctx, queryStruct, args...)
+	if isPgError(err, pgForeignKeyViolation) {
+		return status.Errorf(codes.FailedPrecondition, "PVZ has receptions")
+	} else if err != nil {
+		pvz.log.Error().Err(err).Msg("DeletePVZ: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "PVZ not found")
+	}
+
+	return nil
+}
+
+// GetNearbyPVZ returns PVZ within req.Radius meters of the point ordered by
+// great-circle distance. The bounding box lets Postgres use the (lat, lon)
+// index before the exact haversine distance is computed.
+func (pvz *PVZRepo) GetNearbyPVZ(ctx context.Context, req models.NearbyPVZReq) ([]models.NearbyPVZRes, error) {
+	var rows []nearbyPVZRow
+
+	nearby := squirrel.Select(pvzColumns...).
+		Column(squirrel.Alias(
+			squirrel.Expr(haversineDistanceExpr, earthRadiusMeters, req.Lat, req.Lat, req.Lon),
+			"distance",
+		)).
+		From("pvz").
+		Where(pvzStatusCond("pvz", "")).
+		Where(boundingBoxCond(req.Lat, req.Lon, req.Radius))
+
+	if req.City != "" {
+		nearby = nearby.Where(squirrel.Eq{"city": req.City})
+	}
+
+	builder := squirrel.Select("*").
+		PlaceholderFormat(squirrel.Dollar).
+		FromSelect(nearby, "nearby").
+		Where(squirrel.LtOrEq{"distance": req.Radius}).
+		OrderBy("distance", "id").
+		Limit(uint64(req.Limit))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetNearbyPVZ: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.GetNearbyPVZ",
+		QueryRow: query,
+	}
+
+	err = pvz.db.DB().ScanAllContext(ctx, &rows, queryStruct, args...)
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetNearbyPVZ: failed to scan rows")
+		return nil, err
+	}
+
+	res := make([]models.NearbyPVZRes, len(rows))
+	for idx, row := range rows {
+		res[idx] = models.NearbyPVZRes{
+			PVZ:      row.PVZRes,
+			Distance: row.Distance,
+		}
+	}
+
+	return res, nil
+}
+
+func (pvz *PVZRepo) GetPVZList(ctx context.Context, filter models.PVZFilter, after *cursor.Cursor, limit int) (
+	[]models.PVZRes, error) {
+	var res []models.PVZRes
+
+	builder := squirrel.Select(pvzColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz").
+		Where(pvzFilterCond("pvz", filter)).
+		OrderBy("created_at", "id").
+		Limit(uint64(limit))
+
+	if after != nil {
+		builder = builder.Where(squirrel.Expr("(created_at, id) > (?, ?)", after.CreatedAt, after.Id))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetPVZList: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.GetPVZList",
+		QueryRow: query,
+	}
+
+	err = pvz.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetPVZList: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (pvz *PVZRepo) GetFullPVZInfo(ctx context.Context, params models.GetPVZReq, after *cursor.Cursor, limit, offset int) (
+	[]models.FullPVZRes, error) {
+	// Сначала выбираем страницу ПВЗ, затем присоединяем к ней приёмки и товары,
+	// чтобы LIMIT/OFFSET применялись к ПВЗ, а не к строкам соединения
+	page := squirrel.Select("id", "city", "created_at", "status", "address", "lat", "lon").
+		From("pvz").
+		Where(fullPVZCond(params)).
+		OrderBy("created_at", "id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	if after != nil {
+		page = page.Where(squirrel.Expr("(created_at, id) > (?, ?)", after.CreatedAt, after.Id))
+	}
+
+	builder := squirrel.Select(
+		"page.id AS pvz_id",
+		"page.city",
+		"page.created_at AS pvz_created_at",
+		"page.status AS pvz_status",
+		"page.address AS pvz_address",
+		"page.lat AS pvz_lat",
+		"page.lon AS pvz_lon",
+		"r.id AS reception_id",
+		"r.status AS reception_status",
+		"r.created_at AS reception_created",
+		"p.id AS product_id",
+		"p.product_type",
+		"p.created_at AS product_created",
+	).
+		PrefixExpr(squirrel.Expr("WITH page AS (?)", page)).
+		From("page").
+		JoinClause(squirrel.ConcatExpr("LEFT JOIN receptions r ON r.pvz_id = page.id AND ", receptionCond(params))).
+		JoinClause(squirrel.ConcatExpr("LEFT JOIN products p ON p.reception_id = r.id AND ", productCond(params))).
+		OrderBy("page.created_at", "page.id", "r.created_at", "r.id", "p.created_at", "p.id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetFullPVZInfo: failed to build SQL")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.GetFullPVZInfo",
+		QueryRow: query,
+	}
+
+	rows, err := pvz.db.DB().QueryContext(ctx, queryStruct, args...)
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetFullPVZInfo: failed to execute query")
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]models.FullPVZRes, 0, limit)
+
+	err = scanFullPVZRows(rows, func(res models.FullPVZRes, _ fullPVZRow) error {
+		result = append(result, res)
+		return nil
+	})
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("GetFullPVZInfo: failed to scan rows")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (pvz *PVZRepo) CountPVZ(ctx context.Context, params models.GetPVZReq) (int, error) {
+	var total int
+
+	builder := squirrel.Select("COUNT(*)").
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz").
+		Where(fullPVZCond(params))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("CountPVZ: failed to build SQL query")
+		return 0, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.CountPVZ",
+		QueryRow: query,
+	}
+
+	err = pvz.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&total)
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("CountPVZ: failed to execute query")
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// StreamFullPVZInfo passes every PVZ with its receptions to fn together with
+// its schedule for the date of at, which is joined into the query so the
+// caller needs no queries of its own while the rows are read.
+func (pvz *PVZRepo) StreamFullPVZInfo(ctx context.Context, filter models.PVZFilter, at time.Time,
+	fn func(models.FullPVZRes, models.PVZScheduleOnDate) error) error {
+	builder := squirrel.Select(
+		"pvz.id AS pvz_id",
+		"pvz.city",
+		"pvz.created_at AS pvz_created_at",
+		"pvz.status AS pvz_status",
+		"pvz.address AS pvz_address",
+		"pvz.lat AS pvz_lat",
+		"pvz.lon AS pvz_lon",
+		"hs.pvz_id IS NOT NULL AS has_schedule",
+		"to_char(sd.open_time, 'HH24:MI') AS day_open_time",
+		"to_char(sd.close_time, 'HH24:MI') AS day_close_time",
+		"se.is_closed AS exception_closed",
+		"to_char(se.open_time, 'HH24:MI') AS exception_open_time",
+		"to_char(se.close_time, 'HH24:MI') AS exception_close_time",
+		"r.id AS reception_id",
+		"r.status AS reception_status",
+		"r.created_at AS reception_created",
+		"p.id AS product_id",
+		"p.product_type",
+		"p.created_at AS product_created",
+	).
+		From("pvz").
+		// Наличие графика определяется один раз на ПВЗ, а не для каждой строки товара
+		LeftJoin("(SELECT DISTINCT pvz_id FROM pvz_schedule) hs ON hs.pvz_id = pvz.id").
+		LeftJoin("pvz_schedule sd ON sd.pvz_id = pvz.id AND sd.weekday = ?", scheduleWeekday(at)).
+		LeftJoin("pvz_schedule_exceptions se ON se.pvz_id = pvz.id AND se.date = ?", at.Format(scheduleDateLayout)).
+		LeftJoin("receptions r ON r.pvz_id = pvz.id").
+		LeftJoin("products p ON p.reception_id = r.id").
+		Where(pvzFilterCond("pvz", filter)).
+		OrderBy("pvz.created_at", "pvz.id", "r.created_at", "r.id", "p.created_at", "p.id").
+		PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("StreamFullPVZInfo: failed to build SQL")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_repository.StreamFullPVZInfo",
+		QueryRow: query,
+	}
+
+	rows, err := pvz.db.DB().QueryContext(ctx, queryStruct, args...)
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("StreamFullPVZInfo: failed to execute query")
+		return err
+	}
+	defer rows.Close()
+
+	err = scanFullPVZRows(rows, func(res models.FullPVZRes, row fullPVZRow) error {
+		return fn(res, row.scheduleOnDate(at))
+	})
+	if err != nil {
+		pvz.log.Error().Err(err).Msg("StreamFullPVZInfo: failed to scan rows")
+		return err
+	}
+
+	return nil
+}
+
+// scanFullPVZRows groups joined rows ordered by PVZ into nested PVZ entries and
+// passes each one, with its first row, to fn as soon as the next PVZ starts,
+// so only the current PVZ is kept in memory.
+func scanFullPVZRows(rows pgx.Rows, fn func(models.FullPVZRes, fullPVZRow) error) error {
+	var (
+		current *models.FullPVZRes
+		first   fullPVZRow
+	)
+
+	scanner := pgxscan.NewRowScanner(rows)
+	for rows.Next() {
+		var row fullPVZRow
+		if err := scanner.Scan(&row); err != nil {
+			return err
+		}
+
+		if current != nil && *current.Id != row.PVZID {
+			if err := fn(*current, first); err != nil {
+				return err
+			}
+			current = nil
+		}
+
+		if current == nil {
+			first = row
+			id := row.PVZID
+			current = &models.FullPVZRes{
+				Id:               &id,
+				City:             derefString(row.City),
+				RegistrationDate: row.PVZCreatedAt,
+				Status:           derefString(row.PVZStatus),
+				Address:          derefString(row.PVZAddress),
+				Lat:              row.PVZLat,
+				Lon:              row.PVZLon,
+			}
+		}
+
+		appendFullPVZRow(current, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if current != nil {
+		return fn(*current, first)
+	}
+
+	return nil
+}
+
+// appendFullPVZRow adds the reception and product of a joined row to the PVZ.
+// Rows must be ordered by reception, so only the last reception is checked.
+func appendFullPVZRow(pvzEntry *models.FullPVZRes, row fullPVZRow) {
+	if row.ReceptionID == nil {
+		return
+	}
+
+	last := len(pvzEntry.Receptions) - 1
+	if last < 0 || pvzEntry.Receptions[last].Id != *row.ReceptionID {
+		pvzEntry.Receptions = append(pvzEntry.Receptions, models.ReceptionRes{
+			Id:        *row.ReceptionID,
+			Status:    derefString(row.ReceptionStatus),
+			CreatedAt: derefTime(row.ReceptionCreated),
+		})
+		last++
+	}
+
+	if row.ProductID != nil {
+		pvzEntry.Receptions[last].Products = append(pvzEntry.Receptions[last].Products, models.ProductRes{
+			Id:          *row.ProductID,
+			ProductType: derefString(row.ProductType),
+			CreatedAt:   derefTime(row.ProductCreatedAt),
+		})
+	}
+}
+
+// fullPVZCond selects PVZ in the city that have at least one reception matching
+// the reception filters; without reception filters every PVZ in the city matches.
+func fullPVZCond(params models.GetPVZReq) squirrel.And {
+	cond := squirrel.And{}
+
+	if params.City != "" {
+		cond = append(cond, squirrel.Eq{"pvz.city": params.City})
+	}
+
+	cond = append(cond, pvzStatusCond("pvz", params.PVZStatus))
+
+	if recCond := receptionCond(params); len(recCond) > 0 {
+		receptions := squirrel.Select("1").
+			From("receptions r").
+			Where("r.pvz_id = pvz.id").
+			Where(recCond)
+
+		cond = append(cond, squirrel.Expr("EXISTS (?)", receptions))
+	}
+
+	return cond
+}
+
+// receptionCond filters receptions joined as "r" by date range, status and
+// the type of their products.
+func receptionCond(params models.GetPVZReq) squirrel.And {
+	cond := squirrel.And{}
+
+	if params.StartDate != nil {
+		cond = append(cond, squirrel.GtOrEq{"r.created_at": *params.StartDate})
+	}
+
+	if params.EndTime != nil {
+		cond = append(cond, squirrel.LtOrEq{"r.created_at": *params.EndTime})
+	}
+
+	if params.ReceptionStatus != "" {
+		cond = append(cond, squirrel.Eq{"r.status": params.ReceptionStatus})
+	}
+
+	if params.ProductType != "" {
+		cond = append(cond, squirrel.Expr(
+			"EXISTS (SELECT 1 FROM products fp WHERE fp.reception_id = r.id AND fp.product_type = ?)",
+			params.ProductType,
+		))
+	}
+
+	return cond
+}
+
+func productCond(params models.GetPVZReq) squirrel.And {
+	cond := squirrel.And{}
+
+	if params.ProductType != "" {
+		cond = append(cond, squirrel.Eq{"p.product_type": params.ProductType})
+	}
+
+	return cond
+}
+
+func pvzFilterCond(table string, filter models.PVZFilter) squirrel.And {
+	cond := squirrel.And{}
+
+	if filter.City != "" {
+		cond = append(cond, squirrel.Eq{table + ".city": filter.City})
+	}
+
+	cond = append(cond, pvzStatusCond(table, filter.Status))
+
+	if filter.StartDate != nil {
+		cond = append(cond, squirrel.GtOrEq{table + ".created_at": *filter.StartDate})
+	}
+
+	if filter.EndDate != nil {
+		cond = append(cond, squirrel.LtOrEq{table + ".created_at": *filter.EndDate})
+	}
+
+	return cond
+}
+
+type fullPVZRow struct {
+	PVZID            uuid.UUID  `db:"pvz_id"`
+	City             *string    `db:"city"`
+	PVZCreatedAt     *time.Time `db:"pvz_created_at"`
+	PVZStatus        *string    `db:"pvz_status"`
+	PVZAddress       *string    `db:"pvz_address"`
+	PVZLat           *float64   `db:"pvz_lat"`
+	PVZLon           *float64   `db:"pvz_lon"`
+	HasSchedule      *bool      `db:"has_schedule"`
+	DayOpenTime      *string    `db:"day_open_time"`
+	DayCloseTime     *string    `db:"day_close_time"`
+	ExceptionClosed  *bool      `db:"exception_closed"`
+	ExceptionOpen    *string    `db:"exception_open_time"`
+	ExceptionClose   *string    `db:"exception_close_time"`
+	ReceptionID      *uuid.UUID `db:"reception_id"`
+	ReceptionStatus  *string    `db:"reception_status"`
+	ReceptionCreated *time.Time `db:"reception_created"`
+	ReceptionClosed  *time.Time `db:"reception_closed"`
+	ProductID        *uuid.UUID `db:"product_id"`
+	ProductType      *string    `db:"product_type"`
+	ProductCreatedAt *time.Time `db:"product_created"`
+}
+
+type nearbyPVZRow struct {
+	models.PVZRes
+	Distance float64 `db:"distance"`
+}
+
+// boundingBoxCond limits the search to the square around the point; the
+// longitude bound is skipped near the poles and the antimeridian where it wraps.
+func boundingBoxCond(lat, lon, radius float64) squirrel.And {
+	latDelta := radius / metersPerLatitudeDeg
+
+	cond := squirrel.And{
+		squirrel.Expr("lat BETWEEN ? AND ?", lat-latDelta, lat+latDelta),
+		squirrel.NotEq{"lon": nil},
+	}
+
+	cosLat := math.Cos(lat * math.Pi / 180)
+	if lat+latDelta >= 90 || lat-latDelta <= -90 || cosLat <= 0 {
+		return cond
+	}
+
+	lonDelta := latDelta / cosLat
+	if lon-lonDelta < -180 || lon+lonDelta > 180 {
+		return cond
+	}
+
+	return append(cond, squirrel.Expr("lon BETWEEN ? AND ?", lon-lonDelta, lon+lonDelta))
+}
+
+// pvzStatusCond filters PVZ by status; closed PVZ are hidden unless requested explicitly.
+func pvzStatusCond(table string, pvzStatus string) squirrel.Sqlizer {
+	if pvzStatus != "" {
+		return squirrel.Eq{table + ".status": pvzStatus}
+	}
+
+	return squirrel.NotEq{table + ".status": pvzStatusClosed}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+// scheduleOnDate reads the schedule columns joined by StreamFullPVZInfo.
+func (row fullPVZRow) scheduleOnDate(at time.Time) models.PVZScheduleOnDate {
+	res := models.PVZScheduleOnDate{
+		HasSchedule: row.HasSchedule != nil && *row.HasSchedule,
+	}
+
+	if row.DayOpenTime != nil && row.DayCloseTime != nil {
+		res.Day = &models.PVZScheduleDay{
+			Weekday:   scheduleWeekday(at),
+			OpenTime:  *row.DayOpenTime,
+			CloseTime: *row.DayCloseTime,
+		}
+	}
+
+	if row.ExceptionClosed != nil {
+		res.Exception = &models.PVZScheduleException{
+			Date:      at,
+			IsClosed:  *row.ExceptionClosed,
+			OpenTime:  row.ExceptionOpen,
+			CloseTime: row.ExceptionClose,
+		}
+	}
+
+	return res
+}
+
+// scheduleWeekday numbers the weekdays from Monday as in pvz_schedule.
+func scheduleWeekday(at time.Time) int {
+	if at.Weekday() == time.Sunday {
+		return 7
+	}
+
+	return int(at.Weekday())
+}
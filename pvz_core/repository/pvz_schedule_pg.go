@@ -0,0 +1,308 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PVZSchedules interface {
+	GetScheduleDays(ctx context.Context, pvzIds []uuid.UUID) (map[uuid.UUID][]models.PVZScheduleDay, error)
+	ReplaceScheduleDays(ctx context.Context, pvzId uuid.UUID, days []models.PVZScheduleDay) error
+	GetScheduleExceptions(ctx context.Context, pvzId uuid.UUID, from time.Time) ([]models.PVZScheduleException, error)
+	GetScheduleExceptionsByDate(ctx context.Context, pvzIds []uuid.UUID, date time.Time) (
+		map[uuid.UUID]models.PVZScheduleException, error)
+	UpsertScheduleException(ctx context.Context, pvzId uuid.UUID, exception models.PVZScheduleException) (
+		models.PVZScheduleException, error)
+	DeleteScheduleException(ctx context.Context, pvzId uuid.UUID, date time.Time) error
+}
+
+type PVZSchedulesRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newPVZSchedulesRepository(db db.Client, log zerolog.Logger) *PVZSchedulesRepo {
+	return &PVZSchedulesRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+const scheduleDateLayout = "2006-01-02"
+
+var scheduleExceptionColumns = []string{
+	"pvz_id",
+	"date",
+	"is_closed",
+	"to_char(open_time, 'HH24:MI') AS open_time",
+	"to_char(close_time, 'HH24:MI') AS close_time",
+}
+
+type scheduleDayRow struct {
+	PVZId     uuid.UUID `db:"pvz_id"`
+	Weekday   int       `db:"weekday"`
+	OpenTime  string    `db:"open_time"`
+	CloseTime string    `db:"close_time"`
+}
+
+type scheduleExceptionRow struct {
+	PVZId     uuid.UUID `db:"pvz_id"`
+	Date      time.Time `db:"date"`
+	IsClosed  bool      `db:"is_closed"`
+	OpenTime  *string   `db:"open_time"`
+	CloseTime *string   `db:"close_time"`
+}
+
+func (row scheduleExceptionRow) toModel() models.PVZScheduleException {
+	return models.PVZScheduleException{
+		Date:      row.Date,
+		IsClosed:  row.IsClosed,
+		OpenTime:  row.OpenTime,
+		CloseTime: row.CloseTime,
+	}
+}
+
+func (sch *PVZSchedulesRepo) GetScheduleDays(ctx context.Context, pvzIds []uuid.UUID) (
+	map[uuid.UUID][]models.PVZScheduleDay, error) {
+	var rows []scheduleDayRow
+
+	builder := squirrel.Select(
+		"pvz_id",
+		"weekday",
+		"to_char(open_time, 'HH24:MI') AS open_time",
+		"to_char(close_time, 'HH24:MI') AS close_time",
+	).
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz_schedule").
+		Where(squirrel.Eq{"pvz_id": pvzIds}).
+		OrderBy("pvz_id", "weekday")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		sch.log.Error().Err(err).Msg("GetScheduleDays: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_schedules_repository.GetScheduleDays",
+		QueryRow: query,
+	}
+
+	err = sch.db.DB().ScanAllContext(ctx, &rows, queryStruct, args...)
+	if err != nil {
+		sch.log.Error().Err(err).Msg("GetScheduleDays: failed to scan rows")
+		return nil, err
+	}
+
+	res := make(map[uuid.UUID][]models.PVZScheduleDay, len(pvzIds))
+	for _, row := range rows {
+		res[row.PVZId] = append(res[row.PVZId], models.PVZScheduleDay{
+			Weekday:   row.Weekday,
+			OpenTime:  row.OpenTime,
+			CloseTime: row.CloseTime,
+		})
+	}
+
+	return res, nil
+}
+
+// ReplaceScheduleDays drops the weekly schedule of the PVZ and stores the new
+// one; it has to run in a transaction.
+func (sch *PVZSchedulesRepo) ReplaceScheduleDays(ctx context.Context, pvzId uuid.UUID, days []models.PVZScheduleDay) error {
+	deleteBuilder := squirrel.Delete("pvz_schedule").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"pvz_id": pvzId})
+
+	query, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		sch.log.Error().Err(err).Msg("ReplaceScheduleDays: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_schedules_repository.ReplaceScheduleDays.Delete",
+		QueryRow: query,
+	}
+
+	if _, err = sch.db.DB().ExecContext(ctx, queryStruct, args...); err != nil {
+		sch.log.Error().Err(err).Msg("ReplaceScheduleDays: failed to delete schedule")
+		return err
+	}
+
+	if len(days) == 0 {
+		return nil
+	}
+
+	insertBuilder := squirrel.Insert("pvz_schedule").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("pvz_id", "weekday", "open_time", "close_time")
+
+	for _, day := range days {
+		insertBuilder = insertBuilder.Values(pvzId, day.Weekday, day.OpenTime, day.CloseTime)
+	}
+
+	query, args, err = insertBuilder.ToSql()
+	if err != nil {
+		sch.log.Error().Err(err).Msg("ReplaceScheduleDays: failed to build SQL query")
+		return err
+	}
+
+	queryStruct = db.Query{
+		Name:     "pvz_schedules_repository.ReplaceScheduleDays.Insert",
+		QueryRow: query,
+	}
+
+	_, err = sch.db.DB().ExecContext(ctx, queryStruct, args...)
+	if isPgError(err, pgForeignKeyViolation) {
+		return status.Errorf(codes.NotFound, "PVZ not found")
+	} else if err != nil {
+		sch.log.Error().Err(err).Msg("ReplaceScheduleDays: failed to insert schedule")
+		return err
+	}
+
+	return nil
+}
+
+func (sch *PVZSchedulesRepo) GetScheduleExceptions(ctx context.Context, pvzId uuid.UUID, from time.Time) (
+	[]models.PVZScheduleException, error) {
+	var rows []scheduleExceptionRow
+
+	builder := squirrel.Select(scheduleExceptionColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz_schedule_exceptions").
+		Where(squirrel.Eq{"pvz_id": pvzId}).
+		Where(squirrel.GtOrEq{"date": from.Format(scheduleDateLayout)}).
+		OrderBy("date")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		sch.log.Error().Err(err).Msg("GetScheduleExceptions: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_schedules_repository.GetScheduleExceptions",
+		QueryRow: query,
+	}
+
+	err = sch.db.DB().ScanAllContext(ctx, &rows, queryStruct, args...)
+	if err != nil {
+		sch.log.Error().Err(err).Msg("GetScheduleExceptions: failed to scan rows")
+		return nil, err
+	}
+
+	res := make([]models.PVZScheduleException, len(rows))
+	for idx, row := range rows {
+		res[idx] = row.toModel()
+	}
+
+	return res, nil
+}
+
+func (sch *PVZSchedulesRepo) GetScheduleExceptionsByDate(ctx context.Context, pvzIds []uuid.UUID, date time.Time) (
+	map[uuid.UUID]models.PVZScheduleException, error) {
+	var rows []scheduleExceptionRow
+
+	builder := squirrel.Select(scheduleExceptionColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz_schedule_exceptions").
+		Where(squirrel.Eq{"pvz_id": pvzIds}).
+		Where(squirrel.Eq{"date": date.Format(scheduleDateLayout)})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		sch.log.Error().Err(err).Msg("GetScheduleExceptionsByDate: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_schedules_repository.GetScheduleExceptionsByDate",
+		QueryRow: query,
+	}
+
+	err = sch.db.DB().ScanAllContext(ctx, &rows, queryStruct, args...)
+	if err != nil {
+		sch.log.Error().Err(err).Msg("GetScheduleExceptionsByDate: failed to scan rows")
+		return nil, err
+	}
+
+	res := make(map[uuid.UUID]models.PVZScheduleException, len(rows))
+	for _, row := range rows {
+		res[row.PVZId] = row.toModel()
+	}
+
+	return res, nil
+}
+
+func (sch *PVZSchedulesRepo) UpsertScheduleException(ctx context.Context, pvzId uuid.UUID,
+	exception models.PVZScheduleException) (models.PVZScheduleException, error) {
+	var row scheduleExceptionRow
+
+	builder := squirrel.Insert("pvz_schedule_exceptions").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("pvz_id", "date", "is_closed", "open_time", "close_time").
+		Values(pvzId, exception.Date.Format(scheduleDateLayout), exception.IsClosed, exception.OpenTime, exception.CloseTime).
+		Suffix("ON CONFLICT (pvz_id, date) DO UPDATE SET " +
+			"is_closed = EXCLUDED.is_closed, open_time = EXCLUDED.open_time, close_time = EXCLUDED.close_time " +
+			"RETURNING " + strings.Join(scheduleExceptionColumns, ", "))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		sch.log.Error().Err(err).Msg("UpsertScheduleException: failed to build SQL query")
+		return models.PVZScheduleException{}, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_schedules_repository.UpsertScheduleException",
+		QueryRow: query,
+	}
+
+	err = sch.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&row.PVZId, &row.Date, &row.IsClosed, &row.OpenTime, &row.CloseTime)
+	if isPgError(err, pgForeignKeyViolation) {
+		return models.PVZScheduleException{}, status.Errorf(codes.NotFound, "PVZ not found")
+	} else if err != nil {
+		sch.log.Error().Err(err).Msg("UpsertScheduleException: failed to execute query")
+		return models.PVZScheduleException{}, err
+	}
+
+	return row.toModel(), nil
+}
+
+func (sch *PVZSchedulesRepo) DeleteScheduleException(ctx context.Context, pvzId uuid.UUID, date time.Time) error {
+	builder := squirrel.Delete("pvz_schedule_exceptions").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"pvz_id": pvzId, "date": date.Format(scheduleDateLayout)})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		sch.log.Error().Err(err).Msg("DeleteScheduleException: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_schedules_repository.DeleteScheduleException",
+		QueryRow: query,
+	}
+
+	tag, err := sch.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		sch.log.Error().Err(err).Msg("DeleteScheduleException: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "Schedule exception not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PVZStaff interface {
+	AssignEmployee(ctx context.Context, pvzId, userId uuid.UUID) error
+	UnassignEmployee(ctx context.Context, pvzId, userId uuid.UUID) error
+	UnassignFromAllPVZ(ctx context.Context, userId uuid.UUID) error
+	GetPVZStaff(ctx context.Context, pvzId uuid.UUID) ([]models.PVZStaff, error)
+	IsAssigned(ctx context.Context, pvzId, userId uuid.UUID) (bool, error)
+}
+
+type PVZStaffRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newPVZStaffRepository(db db.Client, log zerolog.Logger) *PVZStaffRepo {
+	return &PVZStaffRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (stf *PVZStaffRepo) AssignEmployee(ctx context.Context, pvzId, userId uuid.UUID) error {
+	builder := squirrel.Insert("pvz_staff").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("pvz_id", "user_id").
+		Values(pvzId, userId)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		stf.log.Error().Err(err).Msg("AssignEmployee: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_staff_repository.AssignEmployee",
+		QueryRow: query,
+	}
+
+	_, err = stf.db.DB().ExecContext(ctx, queryStruct, args...)
+	if isPgError(err, pgUniqueViolation) {
+		return status.Errorf(codes.AlreadyExists, "Employee already assigned")
+	} else if isPgError(err, pgForeignKeyViolation) {
+		return status.Errorf(codes.NotFound, "PVZ or user not found")
+	} else if err != nil {
+		stf.log.Error().Err(err).Msg("AssignEmployee: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (stf *PVZStaffRepo) UnassignEmployee(ctx context.Context, pvzId, userId uuid.UUID) error {
+	builder := squirrel.Delete("pvz_staff").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"pvz_id": pvzId, "user_id": userId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		stf.log.Error().Err(err).Msg("UnassignEmployee: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_staff_repository.UnassignEmployee",
+		QueryRow: query,
+	}
+
+	tag, err := stf.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		stf.log.Error().Err(err).Msg("UnassignEmployee: failed to execute query")
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "Employee not assigned")
+	}
+
+	return nil
+}
+
+func (stf *PVZStaffRepo) UnassignFromAllPVZ(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Delete("pvz_staff").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"user_id": userId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		stf.log.Error().Err(err).Msg("UnassignFromAllPVZ: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_staff_repository.UnassignFromAllPVZ",
+		QueryRow: query,
+	}
+
+	_, err = stf.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		stf.log.Error().Err(err).Msg("UnassignFromAllPVZ: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (stf *PVZStaffRepo) GetPVZStaff(ctx context.Context, pvzId uuid.UUID) ([]models.PVZStaff, error) {
+	var res []models.PVZStaff
+
+	builder := squirrel.Select("s.pvz_id", "s.user_id", "u.email", "s.assigned_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("pvz_staff s").
+		Join("users u ON u.id = s.user_id").
+		Where(squirrel.Eq{"s.pvz_id": pvzId}).
+		OrderBy("s.assigned_at", "s.user_id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		stf.log.Error().Err(err).Msg("GetPVZStaff: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_staff_repository.GetPVZStaff",
+		QueryRow: query,
+	}
+
+	err = stf.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		stf.log.Error().Err(err).Msg("GetPVZStaff: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (stf *PVZStaffRepo) IsAssigned(ctx context.Context, pvzId, userId uuid.UUID) (bool, error) {
+	var assigned bool
+
+	builder := squirrel.Select("1").
+		From("pvz_staff").
+		Where(squirrel.Eq{"pvz_id": pvzId, "user_id": userId}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		stf.log.Error().Err(err).Msg("IsAssigned: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "pvz_staff_repository.IsAssigned",
+		QueryRow: query,
+	}
+
+	err = stf.db.DB().QueryRowContext(ctx, queryStruct, args...).Scan(&assigned)
+	if err != nil {
+		stf.log.Error().Err(err).Msg("IsAssigned: failed to execute query")
+		return false, err
+	}
+
+	return assigned, nil
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"strings"
 
-	db "github.com/MaksimovDenis/avito_pvz/internal/client"
-	"github.com/MaksimovDenis/avito_pvz/internal/models"
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/cursor"
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -16,7 +17,14 @@ import (
 type Receptions interface {
 	CreateReception(ctx context.Context, userId, pvzId uuid.UUID) (models.CreateReceptionRes, error)
 	GetLastReceptionByPVZId(ctx context.Context, pvzId uuid.UUID) (models.LastReceptionRes, error)
-	CloseReceptionById(ctx context.Context, receptionId uuid.UUID) (models.CreateReceptionRes, error)
+	CloseReceptionById(ctx context.Context, receptionId, userId uuid.UUID) (models.CreateReceptionRes, error)
+	GetReceptionById(ctx context.Context, receptionId uuid.UUID) (models.ReceptionDetails, error)
+	GetReceptions(ctx context.Context, req models.GetReceptionsReq, after *cursor.Cursor, limit int) (
+		[]models.ReceptionDetails, error)
+}
+
+var receptionColumns = []string{
+	"id", "pvz_id", "status", "user_id AS created_by", "created_at", "closed_by", "close_at AS closed_at",
 }
 
 type ReceptionsRepo struct {
@@ -94,12 +102,15 @@ func (rec *ReceptionsRepo) GetLastReceptionByPVZId(ctx context.Context, pvzId uu
 	return res, nil
 }
 
-func (rec *ReceptionsRepo) CloseReceptionById(ctx context.Context, receptionId uuid.UUID) (models.CreateReceptionRes, error) {
+func (rec *ReceptionsRepo) CloseReceptionById(ctx context.Context, receptionId, userId uuid.UUID) (
+	models.CreateReceptionRes, error) {
 	var res models.CreateReceptionRes
 
 	builder := squirrel.Update("receptions").
 		PlaceholderFormat(squirrel.Dollar).
 		Set("status", "close").
+		Set("close_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Set("closed_by", userId).
 		Where(squirrel.Eq{"id": receptionId}).
 		Suffix("RETURNING id, created_at, pvz_id, status")
 
@@ -123,3 +134,82 @@ func (rec *ReceptionsRepo) CloseReceptionById(ctx context.Context, receptionId u
 
 	return res, nil
 }
+
+func (rec *ReceptionsRepo) GetReceptionById(ctx context.Context, receptionId uuid.UUID) (models.ReceptionDetails, error) {
+	var res models.ReceptionDetails
+
+	builder := squirrel.Select(receptionColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("receptions").
+		Where(squirrel.Eq{"id": receptionId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		rec.log.Error().Err(err).Msg("GetReceptionById: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "receptions_repository.GetReceptionById",
+		QueryRow: query,
+	}
+
+	err = rec.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.PvzId, &res.Status, &res.CreatedBy, &res.CreatedAt, &res.ClosedBy, &res.ClosedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Reception not found")
+	} else if err != nil {
+		rec.log.Error().Err(err).Msg("GetReceptionById: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+// GetReceptions returns the receptions of the PVZ, newest first.
+func (rec *ReceptionsRepo) GetReceptions(ctx context.Context, req models.GetReceptionsReq, after *cursor.Cursor,
+	limit int) ([]models.ReceptionDetails, error) {
+	var res []models.ReceptionDetails
+
+	builder := squirrel.Select(receptionColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From("receptions").
+		Where(squirrel.Eq{"pvz_id": req.PvzId}).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(limit))
+
+	if req.Status != "" {
+		builder = builder.Where(squirrel.Eq{"status": req.Status})
+	}
+
+	if req.From != nil {
+		builder = builder.Where(squirrel.GtOrEq{"created_at": *req.From})
+	}
+
+	if req.To != nil {
+		builder = builder.Where(squirrel.LtOrEq{"created_at": *req.To})
+	}
+
+	if after != nil {
+		builder = builder.Where(squirrel.Expr("(created_at, id) < (?, ?)", after.CreatedAt, after.Id))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		rec.log.Error().Err(err).Msg("GetReceptions: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "receptions_repository.GetReceptions",
+		QueryRow: query,
+	}
+
+	err = rec.db.DB().ScanAllContext(ctx, &res, queryStruct, args...)
+	if err != nil {
+		rec.log.Error().Err(err).Msg("GetReceptions: failed to scan rows")
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/rs/zerolog"
+)
+
+type Repository struct {
+	Authorization
+	PVZ
+	Receptions
+	Products
+	Tokens
+	Cities
+	ProductTypes
+	PVZSchedules
+	PVZStaff
+	Roles
+	LoginAttempts
+	PasswordResets
+	TwoFactor
+	APIKeys
+	Invites
+	EmailVerifications
+}
+
+func NewRepository(db db.Client, log zerolog.Logger) *Repository {
+	return &Repository{
+		Authorization:      newAuthRepository(db, log),
+		PVZ:                newPVZRepository(db, log),
+		Receptions:         newReceptionsRepository(db, log),
+		Products:           newProductsRepository(db, log),
+		Tokens:             newTokensRepository(db, log),
+		Cities:             newCitiesRepository(db, log),
+		ProductTypes:       newProductTypesRepository(db, log),
+		PVZSchedules:       newPVZSchedulesRepository(db, log),
+		PVZStaff:           newPVZStaffRepository(db, log),
+		Roles:              newRolesRepository(db, log),
+		LoginAttempts:      newLoginAttemptsRepository(db, log),
+		PasswordResets:     newPasswordResetsRepository(db, log),
+		TwoFactor:          newTwoFactorRepository(db, log),
+		APIKeys:            newAPIKeysRepository(db, log),
+		Invites:            newInvitesRepository(db, log),
+		EmailVerifications: newEmailVerificationsRepository(db, log),
+	}
+}
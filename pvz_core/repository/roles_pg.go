@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/rs/zerolog"
+)
+
+type Roles interface {
+	GetRoles(ctx context.Context) ([]models.Role, error)
+}
+
+type RolesRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newRolesRepository(db db.Client, log zerolog.Logger) *RolesRepo {
+	return &RolesRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+type rolePermissionRow struct {
+	Name        string  `db:"name"`
+	Description string  `db:"description"`
+	CityScoped  bool    `db:"city_scoped"`
+	Permission  *string `db:"permission"`
+}
+
+// GetRoles returns every role with the permissions granted to it.
+func (rls *RolesRepo) GetRoles(ctx context.Context) ([]models.Role, error) {
+	var rows []rolePermissionRow
+
+	builder := squirrel.Select("r.name", "r.description", "r.city_scoped", "rp.permission").
+		PlaceholderFormat(squirrel.Dollar).
+		From("roles r").
+		LeftJoin("role_permissions rp ON rp.role = r.name").
+		OrderBy("r.name", "rp.permission")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		rls.log.Error().Err(err).Msg("GetRoles: failed to build SQL query")
+		return nil, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "roles_repository.GetRoles",
+		QueryRow: query,
+	}
+
+	err = rls.db.DB().ScanAllContext(ctx, &rows, queryStruct, args...)
+	if err != nil {
+		rls.log.Error().Err(err).Msg("GetRoles: failed to scan rows")
+		return nil, err
+	}
+
+	res := make([]models.Role, 0)
+	for _, row := range rows {
+		if len(res) == 0 || res[len(res)-1].Name != row.Name {
+			res = append(res, models.Role{
+				Name:        row.Name,
+				Description: row.Description,
+				CityScoped:  row.CityScoped,
+				Permissions: make([]string, 0),
+			})
+		}
+
+		if row.Permission != nil {
+			last := &res[len(res)-1]
+			last.Permissions = append(last.Permissions, *row.Permission)
+		}
+	}
+
+	return res, nil
+}
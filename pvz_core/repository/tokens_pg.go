@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Tokens interface {
+	CreateRefreshToken(ctx context.Context, req models.RefreshTokenReq) (models.RefreshTokenRes, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (models.RefreshTokenRes, error)
+	RevokeRefreshToken(ctx context.Context, tokenId uuid.UUID) (bool, error)
+	RevokeUserRefreshToken(ctx context.Context, userId uuid.UUID, tokenHash string) error
+	RevokeAllUserRefreshTokens(ctx context.Context, userId uuid.UUID) error
+	RevokeAccessToken(ctx context.Context, userId uuid.UUID, tokenId string, expiresAt time.Time) error
+	DeleteExpiredRevokedTokens(ctx context.Context) error
+	IsRevoked(ctx context.Context, tokenId string) (bool, error)
+}
+
+type TokensRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newTokensRepository(db db.Client, log zerolog.Logger) *TokensRepo {
+	return &TokensRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (tkn *TokensRepo) CreateRefreshToken(ctx context.Context, req models.RefreshTokenReq) (models.RefreshTokenRes, error) {
+	var res models.RefreshTokenRes
+
+	builder := squirrel.Insert("refresh_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "token_hash", "expires_at").
+		Values(req.UserId, req.TokenHash, req.ExpiresAt).
+		Suffix("RETURNING id, user_id, expires_at")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("CreateRefreshToken: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.CreateRefreshToken",
+		QueryRow: query,
+	}
+
+	err = tkn.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.UserId, &res.ExpiresAt)
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("CreateRefreshToken: failed to execute query")
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (tkn *TokensRepo) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (models.RefreshTokenRes, error) {
+	var res models.RefreshTokenRes
+
+	builder := squirrel.Select("id", "user_id", "expires_at", "revoked_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("refresh_tokens").
+		Where(squirrel.Eq{"token_hash": tokenHash})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("GetRefreshTokenByHash: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.GetRefreshTokenByHash",
+		QueryRow: query,
+	}
+
+	err = tkn.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.UserId, &res.ExpiresAt, &res.RevokedAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Refresh token not found")
+	} else if err != nil {
+		tkn.log.Error().Err(err).Msg("GetRefreshTokenByHash: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (tkn *TokensRepo) RevokeRefreshToken(ctx context.Context, tokenId uuid.UUID) (bool, error) {
+	builder := squirrel.Update("refresh_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("revoked_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": tokenId}).
+		Where(squirrel.Eq{"revoked_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeRefreshToken: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.RevokeRefreshToken",
+		QueryRow: query,
+	}
+
+	tag, err := tkn.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeRefreshToken: failed to execute query")
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (tkn *TokensRepo) RevokeUserRefreshToken(ctx context.Context, userId uuid.UUID, tokenHash string) error {
+	builder := squirrel.Update("refresh_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("revoked_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"user_id": userId}).
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		Where(squirrel.Eq{"revoked_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeUserRefreshToken: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.RevokeUserRefreshToken",
+		QueryRow: query,
+	}
+
+	_, err = tkn.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeUserRefreshToken: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (tkn *TokensRepo) RevokeAllUserRefreshTokens(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("refresh_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("revoked_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"user_id": userId}).
+		Where(squirrel.Eq{"revoked_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeAllUserRefreshTokens: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.RevokeAllUserRefreshTokens",
+		QueryRow: query,
+	}
+
+	_, err = tkn.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeAllUserRefreshTokens: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (tkn *TokensRepo) RevokeAccessToken(ctx context.Context, userId uuid.UUID, tokenId string, expiresAt time.Time) error {
+	builder := squirrel.Insert("revoked_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("jti", "user_id", "expires_at").
+		Values(tokenId, userId, expiresAt).
+		Suffix("ON CONFLICT (jti) DO NOTHING")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeAccessToken: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.RevokeAccessToken",
+		QueryRow: query,
+	}
+
+	_, err = tkn.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("RevokeAccessToken: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (tkn *TokensRepo) DeleteExpiredRevokedTokens(ctx context.Context) error {
+	builder := squirrel.Delete("revoked_tokens").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Expr("expires_at < CURRENT_TIMESTAMP"))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("DeleteExpiredRevokedTokens: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.DeleteExpiredRevokedTokens",
+		QueryRow: query,
+	}
+
+	_, err = tkn.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("DeleteExpiredRevokedTokens: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+// IsRevoked implements token.RevocationList.
+func (tkn *TokensRepo) IsRevoked(ctx context.Context, tokenId string) (bool, error) {
+	var revoked bool
+
+	builder := squirrel.Select("1").
+		Prefix("SELECT EXISTS (").
+		PlaceholderFormat(squirrel.Dollar).
+		From("revoked_tokens").
+		Where(squirrel.Eq{"jti": tokenId}).
+		Suffix(")")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("IsRevoked: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "tokens_repository.IsRevoked",
+		QueryRow: query,
+	}
+
+	err = tkn.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&revoked)
+	if err != nil {
+		tkn.log.Error().Err(err).Msg("IsRevoked: failed to execute query")
+		return false, err
+	}
+
+	return revoked, nil
+}
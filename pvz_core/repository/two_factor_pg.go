@@ -0,0 +1,384 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TwoFactor keeps the TOTP secret of users, their recovery codes and the
+// login challenges waiting for a second factor. Recovery codes and challenge
+// tokens are stored as digests.
+type TwoFactor interface {
+	GetTwoFactor(ctx context.Context, userId uuid.UUID) (models.TwoFactorState, error)
+	SetTwoFactorSecret(ctx context.Context, userId uuid.UUID, secret string) error
+	EnableTwoFactor(ctx context.Context, userId uuid.UUID) error
+	DisableTwoFactor(ctx context.Context, userId uuid.UUID) error
+	UseTwoFactorStep(ctx context.Context, userId uuid.UUID, step int64) (bool, error)
+	ReplaceRecoveryCodes(ctx context.Context, userId uuid.UUID, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userId uuid.UUID, codeHash string) (bool, error)
+	DeleteRecoveryCodes(ctx context.Context, userId uuid.UUID) error
+	CreateTwoFactorChallenge(ctx context.Context, req models.TwoFactorChallengeReq) error
+	GetTwoFactorChallenge(ctx context.Context, tokenHash string) (models.TwoFactorChallenge, error)
+	FailTwoFactorChallenge(ctx context.Context, challengeId uuid.UUID) error
+	DeleteTwoFactorChallenge(ctx context.Context, challengeId uuid.UUID) (bool, error)
+	DeleteExpiredTwoFactorChallenges(ctx context.Context) error
+}
+
+type TwoFactorRepo struct {
+	db  db.Client
+	log zerolog.Logger
+}
+
+func newTwoFactorRepository(db db.Client, log zerolog.Logger) *TwoFactorRepo {
+	return &TwoFactorRepo{
+		db:  db,
+		log: log,
+	}
+}
+
+func (tfa *TwoFactorRepo) GetTwoFactor(ctx context.Context, userId uuid.UUID) (models.TwoFactorState, error) {
+	var res models.TwoFactorState
+
+	builder := squirrel.Select("totp_secret", "totp_enabled", "totp_last_step").
+		PlaceholderFormat(squirrel.Dollar).
+		From("users").
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("GetTwoFactor: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.GetTwoFactor",
+		QueryRow: query,
+	}
+
+	err = tfa.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Secret, &res.Enabled, &res.LastStep)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "User not found")
+	} else if err != nil {
+		tfa.log.Error().Err(err).Msg("GetTwoFactor: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+// SetTwoFactorSecret stores a pending secret, two-factor stays disabled until
+// EnableTwoFactor confirms it.
+func (tfa *TwoFactorRepo) SetTwoFactorSecret(ctx context.Context, userId uuid.UUID, secret string) error {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("totp_secret", secret).
+		Set("totp_enabled", false).
+		Set("totp_last_step", 0).
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	return tfa.execUserUpdate(ctx, "SetTwoFactorSecret", builder)
+}
+
+func (tfa *TwoFactorRepo) EnableTwoFactor(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("totp_enabled", true).
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil}).
+		Where(squirrel.NotEq{"totp_secret": nil})
+
+	return tfa.execUserUpdate(ctx, "EnableTwoFactor", builder)
+}
+
+func (tfa *TwoFactorRepo) DisableTwoFactor(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("totp_secret", nil).
+		Set("totp_enabled", false).
+		Set("totp_last_step", 0).
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+	return tfa.execUserUpdate(ctx, "DisableTwoFactor", builder)
+}
+
+func (tfa *TwoFactorRepo) execUserUpdate(ctx context.Context, method string, builder squirrel.UpdateBuilder) error {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msgf("%s: failed to build SQL query", method)
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository." + method,
+		QueryRow: query,
+	}
+
+	tag, err := tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msgf("%s: failed to execute query", method)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "User not found")
+	}
+
+	return nil
+}
+
+// UseTwoFactorStep remembers the time step of an accepted TOTP code and
+// reports false if that or a later step was already used, so a code can not
+// be replayed.
+func (tfa *TwoFactorRepo) UseTwoFactorStep(ctx context.Context, userId uuid.UUID, step int64) (bool, error) {
+	builder := squirrel.Update("users").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("totp_last_step", step).
+		Where(squirrel.Eq{"id": userId}).
+		Where(squirrel.Lt{"totp_last_step": step})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("UseTwoFactorStep: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.UseTwoFactorStep",
+		QueryRow: query,
+	}
+
+	tag, err := tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("UseTwoFactorStep: failed to execute query")
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (tfa *TwoFactorRepo) ReplaceRecoveryCodes(ctx context.Context, userId uuid.UUID, codeHashes []string) error {
+	if err := tfa.DeleteRecoveryCodes(ctx, userId); err != nil {
+		return err
+	}
+
+	builder := squirrel.Insert("recovery_codes").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "code_hash")
+
+	for _, codeHash := range codeHashes {
+		builder = builder.Values(userId, codeHash)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("ReplaceRecoveryCodes: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.ReplaceRecoveryCodes",
+		QueryRow: query,
+	}
+
+	_, err = tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("ReplaceRecoveryCodes: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (tfa *TwoFactorRepo) UseRecoveryCode(ctx context.Context, userId uuid.UUID, codeHash string) (bool, error) {
+	builder := squirrel.Update("recovery_codes").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("used_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"user_id": userId, "code_hash": codeHash, "used_at": nil})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("UseRecoveryCode: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.UseRecoveryCode",
+		QueryRow: query,
+	}
+
+	tag, err := tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("UseRecoveryCode: failed to execute query")
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (tfa *TwoFactorRepo) DeleteRecoveryCodes(ctx context.Context, userId uuid.UUID) error {
+	builder := squirrel.Delete("recovery_codes").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"user_id": userId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("DeleteRecoveryCodes: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.DeleteRecoveryCodes",
+		QueryRow: query,
+	}
+
+	_, err = tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("DeleteRecoveryCodes: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (tfa *TwoFactorRepo) CreateTwoFactorChallenge(ctx context.Context, req models.TwoFactorChallengeReq) error {
+	builder := squirrel.Insert("two_factor_challenges").
+		PlaceholderFormat(squirrel.Dollar).
+		Columns("user_id", "token_hash", "expires_at").
+		Values(req.UserId, req.TokenHash, req.ExpiresAt)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("CreateTwoFactorChallenge: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.CreateTwoFactorChallenge",
+		QueryRow: query,
+	}
+
+	_, err = tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("CreateTwoFactorChallenge: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (tfa *TwoFactorRepo) GetTwoFactorChallenge(ctx context.Context, tokenHash string) (models.TwoFactorChallenge, error) {
+	var res models.TwoFactorChallenge
+
+	builder := squirrel.Select("id", "user_id", "attempts", "expires_at").
+		PlaceholderFormat(squirrel.Dollar).
+		From("two_factor_challenges").
+		Where(squirrel.Eq{"token_hash": tokenHash})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("GetTwoFactorChallenge: failed to build SQL query")
+		return res, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.GetTwoFactorChallenge",
+		QueryRow: query,
+	}
+
+	err = tfa.db.DB().QueryRowContext(ctx, queryStruct, args...).
+		Scan(&res.Id, &res.UserId, &res.Attempts, &res.ExpiresAt)
+	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+		return res, status.Errorf(codes.NotFound, "Two-factor challenge not found")
+	} else if err != nil {
+		tfa.log.Error().Err(err).Msg("GetTwoFactorChallenge: failed to execute query")
+		return res, status.Errorf(codes.Internal, "Internal server error")
+	}
+
+	return res, nil
+}
+
+func (tfa *TwoFactorRepo) FailTwoFactorChallenge(ctx context.Context, challengeId uuid.UUID) error {
+	builder := squirrel.Update("two_factor_challenges").
+		PlaceholderFormat(squirrel.Dollar).
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Where(squirrel.Eq{"id": challengeId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("FailTwoFactorChallenge: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.FailTwoFactorChallenge",
+		QueryRow: query,
+	}
+
+	_, err = tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("FailTwoFactorChallenge: failed to execute query")
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTwoFactorChallenge reports false if the challenge was already
+// completed, so one challenge can not issue two token pairs.
+func (tfa *TwoFactorRepo) DeleteTwoFactorChallenge(ctx context.Context, challengeId uuid.UUID) (bool, error) {
+	builder := squirrel.Delete("two_factor_challenges").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{"id": challengeId})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("DeleteTwoFactorChallenge: failed to build SQL query")
+		return false, err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.DeleteTwoFactorChallenge",
+		QueryRow: query,
+	}
+
+	tag, err := tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("DeleteTwoFactorChallenge: failed to execute query")
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (tfa *TwoFactorRepo) DeleteExpiredTwoFactorChallenges(ctx context.Context) error {
+	builder := squirrel.Delete("two_factor_challenges").
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Expr("expires_at < CURRENT_TIMESTAMP"))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("DeleteExpiredTwoFactorChallenges: failed to build SQL query")
+		return err
+	}
+
+	queryStruct := db.Query{
+		Name:     "two_factor_repository.DeleteExpiredTwoFactorChallenges",
+		QueryRow: query,
+	}
+
+	_, err = tfa.db.DB().ExecContext(ctx, queryStruct, args...)
+	if err != nil {
+		tfa.log.Error().Err(err).Msg("DeleteExpiredTwoFactorChallenges: failed to execute query")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	PermPVZRead           = "pvz:read"
+	PermPVZCreate         = "pvz:create"
+	PermPVZUpdate         = "pvz:update"
+	PermPVZDelete         = "pvz:delete"
+	PermScheduleManage    = "schedule:manage"
+	PermStaffManage       = "staff:manage"
+	PermReceptionCreate   = "reception:create"
+	PermReceptionClose    = "reception:close"
+	PermProductCreate     = "product:create"
+	PermProductDelete     = "product:delete"
+	PermCityManage        = "city:manage"
+	PermProductTypeManage = "product_type:manage"
+	PermUserManage        = "user:manage"
+	PermAPIKeyManage      = "api_key:manage"
+)
+
+const (
+	rolesCacheTTL          = time.Minute
+	permissionCheckFailure = "ошибка при проверке прав пользователя"
+)
+
+var (
+	ErrPermissionDenied = errors.New("у пользователя нет прав")
+	ErrPVZOutOfScope    = errors.New("ПВЗ находится в другом городе, нет прав на работу с ним")
+	ErrCityRequired     = errors.New("для данной роли необходимо указать город")
+)
+
+type Access interface {
+	HasPermission(ctx context.Context, role, permission string) (bool, error)
+	CityScope(ctx context.Context, userId uuid.UUID, role string) (string, error)
+	CheckPVZScope(ctx context.Context, pvzId uuid.UUID, city string) error
+}
+
+// AccessService resolves role permissions stored in Postgres. Roles change
+// rarely, so they are cached in memory and reloaded once rolesCacheTTL passes.
+type AccessService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+
+	mu       sync.RWMutex
+	roles    map[string]models.Role
+	loadedAt time.Time
+}
+
+func newAccessService(appRepository repository.Repository, log zerolog.Logger) *AccessService {
+	return &AccessService{
+		appRepository: appRepository,
+		log:           log,
+	}
+}
+
+func (acs *AccessService) HasPermission(ctx context.Context, role, permission string) (bool, error) {
+	roles, err := acs.loadRoles(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(roles[role].Permissions, permission), nil
+}
+
+// CityScope returns the city a city scoped role is limited to, or an empty
+// string when the role works with every PVZ.
+func (acs *AccessService) CityScope(ctx context.Context, userId uuid.UUID, role string) (string, error) {
+	roles, err := acs.loadRoles(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if !roles[role].CityScoped {
+		return "", nil
+	}
+
+	user, err := acs.appRepository.Authorization.GetUserById(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return "", ErrPermissionDenied
+	} else if err != nil {
+		return "", errors.New(permissionCheckFailure)
+	}
+
+	if user.City == nil || *user.City == "" {
+		return "", ErrPermissionDenied
+	}
+
+	return *user.City, nil
+}
+
+// CheckPVZScope verifies that the PVZ belongs to the city returned by CityScope.
+func (acs *AccessService) CheckPVZScope(ctx context.Context, pvzId uuid.UUID, city string) error {
+	if city == "" {
+		return nil
+	}
+
+	pvz, err := acs.appRepository.PVZ.GetPVZById(ctx, pvzId)
+	if status.Code(err) == codes.NotFound {
+		return ErrPVZNotFound
+	} else if err != nil {
+		return errors.New(permissionCheckFailure)
+	}
+
+	if pvz.City != city {
+		return ErrPVZOutOfScope
+	}
+
+	return nil
+}
+
+// checkRole validates the role of a user and returns the city to store with
+// it: a city scoped role needs a city while the others never keep one.
+func (acs *AccessService) checkRole(ctx context.Context, roleName string, city *string) (*string, error) {
+	roles, err := acs.loadRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, ok := roles[roleName]
+	if !ok {
+		return nil, ErrInvalidRole
+	}
+
+	if !role.CityScoped {
+		return nil, nil
+	}
+
+	if city == nil || *city == "" {
+		return nil, ErrCityRequired
+	}
+
+	return city, nil
+}
+
+func (acs *AccessService) loadRoles(ctx context.Context) (map[string]models.Role, error) {
+	acs.mu.RLock()
+	roles, loadedAt := acs.roles, acs.loadedAt
+	acs.mu.RUnlock()
+
+	if roles != nil && time.Since(loadedAt) < rolesCacheTTL {
+		return roles, nil
+	}
+
+	list, err := acs.appRepository.Roles.GetRoles(ctx)
+	if err != nil {
+		acs.log.Error().Err(err).Msg("failed to load roles")
+		return nil, errors.New(permissionCheckFailure)
+	}
+
+	roles = make(map[string]models.Role, len(list))
+	for _, role := range list {
+		roles[role.Name] = role
+	}
+
+	acs.mu.Lock()
+	acs.roles, acs.loadedAt = roles, time.Now()
+	acs.mu.Unlock()
+
+	return roles, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type rolesStub struct {
+	roles []models.Role
+	calls int
+}
+
+func (stub *rolesStub) GetRoles(ctx context.Context) ([]models.Role, error) {
+	stub.calls++
+	return stub.roles, nil
+}
+
+func newAccessServiceStub() (*AccessService, *rolesStub) {
+	stub := &rolesStub{roles: []models.Role{
+		{Name: "employee", Permissions: []string{PermPVZRead, PermReceptionCreate}},
+		{Name: "auditor", Permissions: []string{PermPVZRead}},
+		{Name: "regional_manager", CityScoped: true, Permissions: []string{PermPVZRead, PermPVZUpdate}},
+	}}
+
+	return newAccessService(repository.Repository{Roles: stub}, zerolog.Nop()), stub
+}
+
+func TestHasPermission(t *testing.T) {
+	access, stub := newAccessServiceStub()
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		role       string
+		permission string
+		want       bool
+	}{
+		{"Granted permission", "employee", PermReceptionCreate, true},
+		{"Read only role", "auditor", PermReceptionCreate, false},
+		{"Unknown role", "guest", PermPVZRead, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := access.HasPermission(ctx, tt.role, tt.permission)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, allowed)
+		})
+	}
+
+	require.Equal(t, 1, stub.calls, "roles must be loaded once and then served from cache")
+}
+
+func TestCheckRole(t *testing.T) {
+	access, _ := newAccessServiceStub()
+	ctx := context.Background()
+	city := "Казань"
+
+	tests := []struct {
+		name     string
+		req      models.CreateUserReq
+		wantErr  error
+		wantCity *string
+	}{
+		{name: "Unknown role", req: models.CreateUserReq{Role: "guest"}, wantErr: ErrInvalidRole},
+		{name: "City is dropped for global role", req: models.CreateUserReq{Role: "auditor", City: &city}},
+		{name: "City scoped role without city", req: models.CreateUserReq{Role: "regional_manager"}, wantErr: ErrCityRequired},
+		{name: "City scoped role", req: models.CreateUserReq{Role: "regional_manager", City: &city}, wantCity: &city},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			city, err := access.checkRole(ctx, tt.req.Role, tt.req.City)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCity, city)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// APIKeyRole is the role of the service user every API key acts as.
+	// The role has no permissions of its own, they are set on the key.
+	APIKeyRole = "integration"
+
+	apiKeyPrefix            = "pvz_"
+	apiKeyDisplayPrefixSize = 12
+	apiKeyNameMaxLength     = 255
+	apiKeyUserEmailDomain   = "@api-keys.local"
+
+	// apiKeyUserPasswordHash is not a bcrypt hash, so nobody can log in as the
+	// service user with a password.
+	apiKeyUserPasswordHash = "!"
+)
+
+// apiKeyPermissions are the permissions an API key may be granted. Managing
+// users, staff and keys stays with people.
+var apiKeyPermissions = []string{
+	PermPVZRead,
+	PermPVZCreate,
+	PermPVZUpdate,
+	PermPVZDelete,
+	PermScheduleManage,
+	PermReceptionCreate,
+	PermReceptionClose,
+	PermProductCreate,
+	PermProductDelete,
+	PermCityManage,
+	PermProductTypeManage,
+}
+
+// apiKeyPVZPermissions are the permissions of a key restricted to one PVZ:
+// each of their routes names the PVZ, so the restriction can be checked.
+// Lists of PVZ, creating PVZ and the catalogs are not bound to a PVZ.
+var apiKeyPVZPermissions = []string{
+	PermPVZUpdate,
+	PermPVZDelete,
+	PermScheduleManage,
+	PermReceptionCreate,
+	PermReceptionClose,
+	PermProductCreate,
+	PermProductDelete,
+}
+
+var (
+	ErrAPIKeyNameRequired         = errors.New("укажите название API ключа")
+	ErrAPIKeyNameTooLong          = errors.New("название API ключа слишком длинное")
+	ErrAPIKeyPermissionsRequired  = errors.New("укажите права API ключа")
+	ErrAPIKeyPermissionNotAllowed = errors.New("право недоступно для API ключа")
+	ErrAPIKeyPermissionNotForPVZ  = errors.New("право недоступно для API ключа, ограниченного ПВЗ")
+	ErrAPIKeyExpiresInPast        = errors.New("срок действия API ключа должен быть в будущем")
+	ErrAPIKeyNotFound             = errors.New("API ключ не найден")
+	ErrInvalidAPIKey              = errors.New("недействительный API ключ")
+)
+
+type APIKeys interface {
+	CreateAPIKey(ctx context.Context, req models.CreateAPIKeyReq) (models.CreateAPIKeyRes, error)
+	GetAPIKeys(ctx context.Context) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, keyId uuid.UUID) error
+	AuthenticateAPIKey(ctx context.Context, key string) (models.APIKey, error)
+}
+
+type APIKeyService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+	txManager     db.TxManager
+}
+
+func newAPIKeyService(appRepository repository.Repository, log zerolog.Logger, txManager db.TxManager) *APIKeyService {
+	return &APIKeyService{
+		appRepository: appRepository,
+		log:           log,
+		txManager:     txManager,
+	}
+}
+
+// CreateAPIKey issues a key together with a disabled service user, which
+// owns the receptions and products the integration creates. The plain key is
+// returned only here.
+func (aks *APIKeyService) CreateAPIKey(ctx context.Context, req models.CreateAPIKeyReq) (models.CreateAPIKeyRes, error) {
+	var res models.CreateAPIKeyRes
+
+	name := strings.TrimSpace(req.Name)
+
+	permissions, err := validateAPIKey(name, req.Permissions, req.PvzId != nil, req.ExpiresAt)
+	if err != nil {
+		return res, err
+	}
+
+	keyId, err := uuid.NewRandom()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("failed to generate uuid")
+		return res, errors.New("ошибка при создании API ключа")
+	}
+
+	userId, err := uuid.NewRandom()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("failed to generate uuid")
+		return res, errors.New("ошибка при создании API ключа")
+	}
+
+	secret, err := newSecretToken()
+	if err != nil {
+		aks.log.Error().Err(err).Msg("failed to generate api key")
+		return res, errors.New("ошибка при создании API ключа")
+	}
+
+	key := apiKeyPrefix + secret
+
+	err = aks.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, errTx := aks.appRepository.Authorization.CreateUser(ctx, models.CreateUserReq{
+			Id:       userId,
+			Email:    "api-key-" + keyId.String() + apiKeyUserEmailDomain,
+			Password: apiKeyUserPasswordHash,
+			Role:     APIKeyRole,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		_, errTx = aks.appRepository.Authorization.UpdateUser(ctx, models.User{
+			Id:       userId,
+			Role:     APIKeyRole,
+			Disabled: true,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		res.APIKey, errTx = aks.appRepository.APIKeys.CreateAPIKey(ctx, models.APIKeyReq{
+			Id:          keyId,
+			Name:        name,
+			Prefix:      key[:apiKeyDisplayPrefixSize],
+			KeyHash:     hashSecretToken(key),
+			UserId:      userId,
+			Permissions: permissions,
+			PvzId:       req.PvzId,
+			ExpiresAt:   req.ExpiresAt,
+			CreatedBy:   req.CreatedBy,
+		})
+		if status.Code(errTx) == codes.FailedPrecondition {
+			return ErrPVZNotFound
+		}
+
+		return errTx
+	})
+	if errors.Is(err, ErrPVZNotFound) {
+		return res, ErrPVZNotFound
+	} else if err != nil {
+		aks.log.Error().Err(err).Msg("failed to create api key")
+		return res, errors.New("ошибка при создании API ключа")
+	}
+
+	aks.log.Info().Str("api_key_id", keyId.String()).Str("created_by", req.CreatedBy.String()).
+		Strs("permissions", permissions).Msg("api key created")
+
+	res.Key = key
+
+	return res, nil
+}
+
+func (aks *APIKeyService) GetAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	res, err := aks.appRepository.APIKeys.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, errors.New("ошибка при получении API ключей")
+	}
+
+	return res, nil
+}
+
+func (aks *APIKeyService) RevokeAPIKey(ctx context.Context, keyId uuid.UUID) error {
+	err := aks.appRepository.APIKeys.RevokeAPIKey(ctx, keyId)
+	if status.Code(err) == codes.NotFound {
+		return ErrAPIKeyNotFound
+	} else if err != nil {
+		return errors.New("ошибка при отзыве API ключа")
+	}
+
+	aks.log.Info().Str("api_key_id", keyId.String()).Msg("api key revoked")
+
+	return nil
+}
+
+// AuthenticateAPIKey returns the active key matching the presented one and
+// records its use.
+func (aks *APIKeyService) AuthenticateAPIKey(ctx context.Context, key string) (models.APIKey, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return models.APIKey{}, ErrInvalidAPIKey
+	}
+
+	stored, err := aks.appRepository.APIKeys.GetAPIKeyByHash(ctx, hashSecretToken(key))
+	if status.Code(err) == codes.NotFound {
+		return stored, ErrInvalidAPIKey
+	} else if err != nil {
+		return stored, errors.New("ошибка при проверке API ключа")
+	}
+
+	if stored.RevokedAt != nil || (stored.ExpiresAt != nil && stored.ExpiresAt.Before(time.Now())) {
+		return stored, ErrInvalidAPIKey
+	}
+
+	if err = aks.appRepository.APIKeys.TouchAPIKey(ctx, stored.Id); err != nil {
+		aks.log.Error().Err(err).Msg("failed to update api key last use")
+	}
+
+	return stored, nil
+}
+
+// validateAPIKey checks the key settings and returns the permissions sorted
+// and without duplicates.
+func validateAPIKey(name string, permissions []string, pvzBound bool, expiresAt *time.Time) ([]string, error) {
+	switch {
+	case name == "":
+		return nil, ErrAPIKeyNameRequired
+	case len(name) > apiKeyNameMaxLength:
+		return nil, ErrAPIKeyNameTooLong
+	case len(permissions) == 0:
+		return nil, ErrAPIKeyPermissionsRequired
+	case expiresAt != nil && !expiresAt.After(time.Now()):
+		return nil, ErrAPIKeyExpiresInPast
+	}
+
+	res := slices.Clone(permissions)
+	slices.Sort(res)
+
+	res = slices.Compact(res)
+
+	for _, permission := range res {
+		if !slices.Contains(apiKeyPermissions, permission) {
+			return nil, fmt.Errorf("%w: %s", ErrAPIKeyPermissionNotAllowed, permission)
+		}
+
+		if pvzBound && !slices.Contains(apiKeyPVZPermissions, permission) {
+			return nil, fmt.Errorf("%w: %s", ErrAPIKeyPermissionNotForPVZ, permission)
+		}
+	}
+
+	return res, nil
+}
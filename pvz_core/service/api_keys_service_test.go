@@ -0,0 +1,54 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAPIKey(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		keyName     string
+		permissions []string
+		pvzBound    bool
+		expiresAt   *time.Time
+		want        []string
+		wantErr     error
+	}{
+		{"Valid key", "WMS", []string{PermReceptionCreate, PermProductCreate}, false, &future,
+			[]string{PermProductCreate, PermReceptionCreate}, nil},
+		{"Duplicate permissions", "WMS", []string{PermPVZRead, PermPVZRead}, false, nil,
+			[]string{PermPVZRead}, nil},
+		{"Empty name", "", []string{PermPVZRead}, false, nil, nil, ErrAPIKeyNameRequired},
+		{"Long name", strings.Repeat("a", apiKeyNameMaxLength+1), []string{PermPVZRead}, false, nil, nil, ErrAPIKeyNameTooLong},
+		{"Without permissions", "WMS", nil, false, nil, nil, ErrAPIKeyPermissionsRequired},
+		{"Expired", "WMS", []string{PermPVZRead}, false, &past, nil, ErrAPIKeyExpiresInPast},
+		{"User management", "WMS", []string{PermUserManage}, false, nil, nil, ErrAPIKeyPermissionNotAllowed},
+		{"Key management", "WMS", []string{PermAPIKeyManage}, false, nil, nil, ErrAPIKeyPermissionNotAllowed},
+		{"PVZ bound key", "WMS", []string{PermReceptionCreate, PermProductCreate}, true, nil,
+			[]string{PermProductCreate, PermReceptionCreate}, nil},
+		{"PVZ bound key lists PVZ", "WMS", []string{PermPVZRead}, true, nil, nil, ErrAPIKeyPermissionNotForPVZ},
+		{"PVZ bound key creates PVZ", "WMS", []string{PermPVZCreate}, true, nil, nil, ErrAPIKeyPermissionNotForPVZ},
+		{"PVZ bound key manages cities", "WMS", []string{PermCityManage}, true, nil, nil, ErrAPIKeyPermissionNotForPVZ},
+		{"Unknown permission", "WMS", []string{"pvz:everything"}, false, nil, nil, ErrAPIKeyPermissionNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := validateAPIKey(tt.keyName, tt.permissions, tt.pvzBound, tt.expiresAt)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
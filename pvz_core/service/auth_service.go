@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/pkg/util"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	durationAccessToken  time.Duration = 15 * time.Minute
+	durationRefreshToken time.Duration = 30 * 24 * time.Hour
+
+	secretTokenSize = 32
+)
+
+var invalidCharsRegex = regexp.MustCompile(`[\"'<>!#$%^&*()=+\[\]{}|\\/]`)
+
+var (
+	ErrInvalidRefreshToken = errors.New("недействительный refresh токен")
+	ErrEmailRequired       = errors.New("укажите почту")
+	ErrPasswordRequired    = errors.New("укажите пароль")
+	ErrEmailEqualsPassword = errors.New("почта и пароль совпадают")
+	ErrInvalidRole         = errors.New("Неверный формат роли пользователя")
+	ErrInvalidCredentials  = errors.New("неверный логин или пароль")
+	ErrDummyLoginDisabled  = errors.New("тестовая авторизация отключена")
+)
+
+// dummyUsers are seeded by the first migration and back /dummyLogin.
+var dummyUsers = map[string]models.User{
+	"moderator": {
+		Id:    uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		Email: "moderatorDummyLogin@example.com",
+		Role:  "moderator",
+	},
+	"employee": {
+		Id:    uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+		Email: "employeeDummyLogin@example.com",
+		Role:  "employee",
+	},
+}
+
+type Authorization interface {
+	LoginUser(ctx context.Context, req models.LoginUserReq) (models.LoginRes, error)
+	CreateUser(ctx context.Context, req models.CreateUserReq) (models.CreateUserRes, error)
+	DummyLogin(ctx context.Context, role string) (string, error)
+	DisableDummyUsers(ctx context.Context) error
+	RefreshToken(ctx context.Context, refreshToken string) (models.TokenPairRes, error)
+	Logout(ctx context.Context, req models.LogoutReq) error
+}
+
+type AuthService struct {
+	appRepository repository.Repository
+	token         token.JWTMaker
+	log           zerolog.Logger
+	txManager     db.TxManager
+	access        *AccessService
+	passwords     *passwordPolicy
+	dummyLogin    bool
+
+	twoFactorRoles  map[string]struct{}
+	twoFactorIssuer string
+}
+
+func newAuthService(
+	appRepository repository.Repository,
+	token token.JWTMaker,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	access *AccessService,
+	passwords *passwordPolicy,
+	dummyLogin bool,
+	twoFactorConfig config.TwoFactorConfig,
+) *AuthService {
+	twoFactorRoles := make(map[string]struct{}, len(twoFactorConfig.RequiredRoles()))
+	for _, role := range twoFactorConfig.RequiredRoles() {
+		twoFactorRoles[role] = struct{}{}
+	}
+
+	return &AuthService{
+		appRepository: appRepository,
+		token:         token,
+		log:           log,
+		txManager:     txManager,
+		access:        access,
+		passwords:     passwords,
+		dummyLogin:    dummyLogin,
+
+		twoFactorRoles:  twoFactorRoles,
+		twoFactorIssuer: twoFactorConfig.Issuer(),
+	}
+}
+
+func (auth *AuthService) DummyLogin(ctx context.Context, role string) (string, error) {
+	if !auth.dummyLogin {
+		return "", ErrDummyLoginDisabled
+	}
+
+	if err := validateRole(role); err != nil {
+		return "", err
+	}
+
+	user := dummyUsers[role]
+
+	accessToken, _, err := auth.token.CreateDummyToken(user.Id, user.Email, user.Role, durationAccessToken)
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to create dummy access token")
+		return "", err
+	}
+
+	return accessToken, nil
+}
+
+// DisableDummyUsers soft deletes the seeded dummy users, whose passwords are
+// publicly known, and ends their sessions. It runs on start when dummy login
+// is turned off and does nothing if they are already deleted.
+func (auth *AuthService) DisableDummyUsers(ctx context.Context) error {
+	err := auth.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		for _, user := range dummyUsers {
+			errTx := auth.appRepository.Authorization.DeleteUser(ctx, user.Id)
+			if status.Code(errTx) == codes.NotFound {
+				continue
+			} else if errTx != nil {
+				return errTx
+			}
+
+			auth.log.Warn().Str("user_id", user.Id.String()).Msg("dummy user disabled")
+
+			if errTx = auth.appRepository.PVZStaff.UnassignFromAllPVZ(ctx, user.Id); errTx != nil {
+				return errTx
+			}
+
+			if errTx = auth.appRepository.Tokens.RevokeAllUserRefreshTokens(ctx, user.Id); errTx != nil {
+				return errTx
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to disable dummy users")
+		return errors.New("ошибка при отключении тестовых пользователей")
+	}
+
+	return nil
+}
+
+// CreateUser creates the account as requested, without an invite. Public
+// registration goes through Registration.Register.
+func (auth *AuthService) CreateUser(ctx context.Context, req models.CreateUserReq) (models.CreateUserRes, error) {
+	var res models.CreateUserRes
+
+	req, err := auth.prepareUser(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	newUser, err := auth.appRepository.Authorization.CreateUser(ctx, req)
+	if status.Code(err) == codes.FailedPrecondition {
+		return res, ErrCityNotSupported
+	} else if err != nil {
+		auth.log.Error().Err(err).Msg("failed to create new user in storage")
+		return res, err
+	}
+
+	return newUser, nil
+}
+
+// prepareUser validates the password and the role, assigns the user id and
+// replaces the password with its hash.
+func (auth *AuthService) prepareUser(ctx context.Context, req models.CreateUserReq) (models.CreateUserReq, error) {
+	if err := auth.passwords.validate(req.Email, req.Password); err != nil {
+		return req, err
+	}
+
+	city, err := auth.access.checkRole(ctx, req.Role, req.City)
+	if err != nil {
+		return req, err
+	}
+
+	req.City = city
+
+	userId, err := uuid.NewRandom()
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to generate uuid")
+		return req, errors.New("ошибка при создании нового пользователя")
+	}
+
+	req.Id = userId
+
+	hashedPwd, err := auth.passwords.hash(req.Password)
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to hash password")
+		return req, errors.New("неверный логин или пароль")
+	}
+
+	req.Password = hashedPwd
+
+	return req, nil
+}
+
+// LoginUser answers the same way for an unknown email and a wrong password,
+// and counts failures per account and per client IP to lock out brute force.
+// Users with two-factor, or whose role requires it, get a challenge to
+// complete with LoginTwoFactor instead of a token pair.
+func (auth *AuthService) LoginUser(ctx context.Context, req models.LoginUserReq) (models.LoginRes, error) {
+	var res models.LoginRes
+
+	if err := validateData(req.Email, req.Password); err != nil {
+		return res, err
+	}
+
+	now := time.Now()
+	keys := loginKeys(req.Email, req.IP)
+
+	if err := auth.checkLoginLock(ctx, keys, now); err != nil {
+		return res, err
+	}
+
+	user, err := auth.appRepository.Authorization.LoginUser(ctx, req)
+	if status.Code(err) == codes.NotFound {
+		_ = util.CheckPassword(req.Password, auth.passwords.dummyHash())
+		auth.registerLoginFailure(ctx, keys, now)
+
+		return res, ErrInvalidCredentials
+	} else if err != nil {
+		auth.log.Error().Err(err).Msg("failed to get user from storage")
+		return res, err
+	}
+
+	if err = util.CheckPassword(req.Password, user.Password_hash); err != nil {
+		auth.log.Warn().Str("user_id", user.Id.String()).Msg("password mismatch")
+		auth.registerLoginFailure(ctx, keys, now)
+
+		return res, ErrInvalidCredentials
+	}
+
+	if user.Disabled {
+		return res, ErrUserDisabled
+	}
+
+	if !user.EmailVerified {
+		return res, ErrEmailNotVerified
+	}
+
+	// Пароль перехешируется только для учётной записи, которой разрешён вход
+	if auth.passwords.needsRehash(user.Password_hash) {
+		auth.rehashPassword(ctx, user.Id, req.Password)
+	}
+
+	if user.TwoFactorEnabled || auth.twoFactorRequired(user.Role) {
+		challenge, err := auth.startTwoFactor(ctx, user)
+		if err != nil {
+			return res, err
+		}
+
+		res.Challenge = &challenge
+
+		return res, nil
+	}
+
+	// С двухфакторной аутентификацией счётчик сбрасывается только после
+	// принятого кода, иначе повторный вход снимал бы блокировку подбора кода
+	auth.resetLoginFailures(ctx, req.Email, now)
+
+	tokenInfo := models.User{
+		Id:    user.Id,
+		Email: user.Email,
+		Role:  user.Role,
+	}
+
+	res.Tokens, err = auth.generateTokenPair(ctx, tokenInfo)
+
+	return res, err
+}
+
+// RefreshToken rotates a refresh token: the presented token is revoked and a
+// new access/refresh pair is issued. Presenting an already revoked token is
+// treated as token theft and revokes every session of the user.
+func (auth *AuthService) RefreshToken(ctx context.Context, refreshToken string) (models.TokenPairRes, error) {
+	var res models.TokenPairRes
+
+	if refreshToken == "" {
+		return res, ErrInvalidRefreshToken
+	}
+
+	stored, err := auth.appRepository.Tokens.GetRefreshTokenByHash(ctx, hashSecretToken(refreshToken))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return res, ErrInvalidRefreshToken
+		}
+
+		auth.log.Error().Err(err).Msg("failed to get refresh token from storage")
+
+		return res, err
+	}
+
+	if stored.RevokedAt != nil {
+		auth.log.Warn().Str("user_id", stored.UserId.String()).Msg("revoked refresh token reused")
+
+		if err = auth.appRepository.Tokens.RevokeAllUserRefreshTokens(ctx, stored.UserId); err != nil {
+			auth.log.Error().Err(err).Msg("failed to revoke user refresh tokens")
+		}
+
+		return res, ErrInvalidRefreshToken
+	}
+
+	if stored.ExpiresAt.Before(time.Now()) {
+		return res, ErrInvalidRefreshToken
+	}
+
+	err = auth.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		rotated, errTx := auth.appRepository.Tokens.RevokeRefreshToken(ctx, stored.Id)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !rotated {
+			return ErrInvalidRefreshToken
+		}
+
+		user, errTx := auth.appRepository.Authorization.GetUserById(ctx, stored.UserId)
+		if status.Code(errTx) == codes.NotFound {
+			return ErrInvalidRefreshToken
+		} else if errTx != nil {
+			return errTx
+		}
+
+		if user.Disabled {
+			return ErrUserDisabled
+		}
+
+		// Сессии, выданные до включения обязательной 2FA, не продлеваются
+		if !user.TwoFactorEnabled && auth.twoFactorRequired(user.Role) {
+			return ErrTwoFactorRequired
+		}
+
+		res, errTx = auth.generateTokenPair(ctx, user)
+		if errTx != nil {
+			return errTx
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidRefreshToken) || errors.Is(err, ErrUserDisabled) || errors.Is(err, ErrTwoFactorRequired) {
+			return res, err
+		}
+
+		return res, errors.New("ошибка при обновлении токена")
+	}
+
+	return res, nil
+}
+
+func (auth *AuthService) Logout(ctx context.Context, req models.LogoutReq) error {
+	err := auth.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		errTx = auth.appRepository.Tokens.RevokeAccessToken(ctx, req.UserId, req.TokenId, req.TokenExpiresAt)
+		if errTx != nil {
+			return errTx
+		}
+
+		if req.RefreshToken != "" {
+			errTx = auth.appRepository.Tokens.RevokeUserRefreshToken(ctx, req.UserId, hashSecretToken(req.RefreshToken))
+			if errTx != nil {
+				return errTx
+			}
+		}
+
+		return auth.appRepository.Tokens.DeleteExpiredRevokedTokens(ctx)
+	})
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to logout user")
+		return errors.New("ошибка при выходе из системы")
+	}
+
+	return nil
+}
+
+// rehashPassword moves the password to the configured bcrypt cost. A failure
+// is only logged: the user has already been authenticated.
+func (auth *AuthService) rehashPassword(ctx context.Context, userId uuid.UUID, password string) {
+	hashedPwd, err := auth.passwords.hash(password)
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to rehash password")
+		return
+	}
+
+	if err = auth.appRepository.Authorization.UpdatePassword(ctx, userId, hashedPwd); err != nil {
+		auth.log.Error().Err(err).Msg("failed to store rehashed password")
+	}
+}
+
+func (auth *AuthService) generateToken(user models.User) (string, error) {
+	accessToken, _, err := auth.token.CreateToken(user.Id, user.Email, user.Role, durationAccessToken)
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to create access token")
+		return "", err
+	}
+
+	return accessToken, nil
+}
+
+func (auth *AuthService) generateTokenPair(ctx context.Context, user models.User) (models.TokenPairRes, error) {
+	var res models.TokenPairRes
+
+	accessToken, err := auth.generateToken(user)
+	if err != nil {
+		return res, err
+	}
+
+	refreshToken, err := newSecretToken()
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to generate refresh token")
+		return res, err
+	}
+
+	_, err = auth.appRepository.Tokens.CreateRefreshToken(ctx, models.RefreshTokenReq{
+		UserId:    user.Id,
+		TokenHash: hashSecretToken(refreshToken),
+		ExpiresAt: time.Now().Add(durationRefreshToken),
+	})
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to store refresh token")
+		return res, err
+	}
+
+	res.AccessToken = accessToken
+	res.RefreshToken = refreshToken
+
+	return res, nil
+}
+
+func newSecretToken() (string, error) {
+	buf := make([]byte, secretTokenSize)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Refresh, password reset, email verification, invite and two-factor challenge
+// tokens are stored as a SHA-256 digest so a database leak does not expose
+// usable credentials.
+func hashSecretToken(secretToken string) string {
+	sum := sha256.Sum256([]byte(secretToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func validateData(email, password string) error {
+	switch {
+	case email == "":
+		return ErrEmailRequired
+	case password == "":
+		return ErrPasswordRequired
+	case email == password:
+		return ErrEmailEqualsPassword
+	default:
+		return nil
+	}
+}
+
+// validateRole limits dummy login to the roles that have a predefined user.
+func validateRole(role string) error {
+	if role != "employee" && role != "moderator" {
+		return ErrInvalidRole
+	}
+
+	return nil
+}
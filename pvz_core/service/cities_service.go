@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrCityNameRequired  = errors.New("укажите название города")
+	ErrCityAlreadyExists = errors.New("город уже существует")
+	ErrCityNotFound      = errors.New("город не найден")
+	ErrCityInUse         = errors.New("в городе есть ПВЗ, удаление невозможно")
+	ErrCityNoChanges     = errors.New("не указаны поля для изменения")
+)
+
+type City interface {
+	CreateCity(ctx context.Context, req models.CreateCityReq) (models.City, error)
+	GetCities(ctx context.Context) ([]models.City, error)
+	UpdateCity(ctx context.Context, cityId uuid.UUID, req models.UpdateCityReq) (models.City, error)
+	DeleteCity(ctx context.Context, cityId uuid.UUID) error
+}
+
+type CityService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+}
+
+func newCityService(appRepository repository.Repository, log zerolog.Logger) *CityService {
+	return &CityService{
+		appRepository: appRepository,
+		log:           log,
+	}
+}
+
+func (ct *CityService) CreateCity(ctx context.Context, req models.CreateCityReq) (models.City, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return models.City{}, ErrCityNameRequired
+	}
+
+	res, err := ct.appRepository.Cities.CreateCity(ctx, req)
+	if status.Code(err) == codes.AlreadyExists {
+		return res, ErrCityAlreadyExists
+	} else if err != nil {
+		return res, errors.New("ошибка при создании города")
+	}
+
+	return res, nil
+}
+
+func (ct *CityService) GetCities(ctx context.Context) ([]models.City, error) {
+	res, err := ct.appRepository.Cities.GetCities(ctx)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка городов")
+	}
+
+	return res, nil
+}
+
+func (ct *CityService) UpdateCity(ctx context.Context, cityId uuid.UUID, req models.UpdateCityReq) (models.City, error) {
+	if req.IsActive == nil && req.LaunchDate == nil {
+		return models.City{}, ErrCityNoChanges
+	}
+
+	res, err := ct.appRepository.Cities.UpdateCity(ctx, cityId, req)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrCityNotFound
+	} else if err != nil {
+		return res, errors.New("ошибка при изменении города")
+	}
+
+	return res, nil
+}
+
+func (ct *CityService) DeleteCity(ctx context.Context, cityId uuid.UUID) error {
+	err := ct.appRepository.Cities.DeleteCity(ctx, cityId)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return ErrCityNotFound
+	case codes.FailedPrecondition:
+		return ErrCityInUse
+	default:
+		return errors.New("ошибка при удалении города")
+	}
+}
+
+// checkCityAvailable allows new PVZ only in active cities whose launch date,
+// if set, has already come.
+func checkCityAvailable(city models.City, now time.Time) error {
+	if !city.IsActive {
+		return ErrCityNotSupported
+	}
+
+	if city.LaunchDate != nil && city.LaunchDate.After(now) {
+		return ErrCityNotSupported
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// Аккаунт блокируется после 5 неудачных попыток подряд, IP — после 20,
+	// каждая следующая неудача удваивает блокировку вплоть до часа.
+	accountLockThreshold = 5
+	ipLockThreshold      = 20
+	baseLoginLockout     = time.Minute
+	maxLoginLockout      = time.Hour
+
+	// Счётчик неудачных попыток сбрасывается, если последняя была раньше.
+	loginAttemptsWindow = 24 * time.Hour
+)
+
+var ErrTooManyLoginAttempts = errors.New("слишком много неудачных попыток входа, попробуйте позже")
+
+type loginKey struct {
+	key       string
+	threshold int
+}
+
+func loginKeys(email, ip string) []loginKey {
+	keys := []loginKey{{key: accountLoginKey(email), threshold: accountLockThreshold}}
+
+	if ip != "" {
+		keys = append(keys, loginKey{key: "ip:" + ip, threshold: ipLockThreshold})
+	}
+
+	return keys
+}
+
+func accountLoginKey(email string) string {
+	return "email:" + strings.ToLower(email)
+}
+
+func (auth *AuthService) checkLoginLock(ctx context.Context, keys []loginKey, now time.Time) error {
+	names := make([]string, len(keys))
+	for idx, key := range keys {
+		names[idx] = key.key
+	}
+
+	lockedUntil, err := auth.appRepository.LoginAttempts.GetLockedUntil(ctx, names)
+	if err != nil {
+		return errors.New("ошибка при входе в систему")
+	}
+
+	if lockedUntil != nil && lockedUntil.After(now) {
+		return ErrTooManyLoginAttempts
+	}
+
+	return nil
+}
+
+// registerLoginFailure counts the failure for every key and locks the keys
+// that reached their threshold. Storage errors are only logged: the caller
+// already rejects the login.
+func (auth *AuthService) registerLoginFailure(ctx context.Context, keys []loginKey, now time.Time) {
+	for _, key := range keys {
+		failures, err := auth.appRepository.LoginAttempts.RegisterLoginFailure(ctx, key.key, now, loginAttemptsWindow)
+		if err != nil {
+			auth.log.Error().Err(err).Msg("failed to register login failure")
+			continue
+		}
+
+		lockout := loginLockout(failures, key.threshold)
+		if lockout == 0 {
+			continue
+		}
+
+		auth.log.Warn().Str("key", key.key).Int("failures", failures).Dur("lockout", lockout).Msg("login locked")
+
+		if err = auth.appRepository.LoginAttempts.LockLogin(ctx, key.key, now.Add(lockout)); err != nil {
+			auth.log.Error().Err(err).Msg("failed to lock login")
+		}
+	}
+}
+
+func (auth *AuthService) resetLoginFailures(ctx context.Context, email string, now time.Time) {
+	if err := auth.appRepository.LoginAttempts.ResetLoginFailures(ctx, accountLoginKey(email)); err != nil {
+		auth.log.Error().Err(err).Msg("failed to reset login failures")
+	}
+
+	if err := auth.appRepository.LoginAttempts.DeleteStaleLoginAttempts(ctx, now.Add(-loginAttemptsWindow)); err != nil {
+		auth.log.Error().Err(err).Msg("failed to delete stale login attempts")
+	}
+}
+
+// loginLockout doubles the lockout with every failure past the threshold.
+func loginLockout(failures, threshold int) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+
+	lockout := baseLoginLockout
+	for i := threshold; i < failures && lockout < maxLoginLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, maxLoginLockout)
+}
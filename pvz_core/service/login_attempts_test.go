@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginLockout(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int
+		want     time.Duration
+	}{
+		{"Below threshold", accountLockThreshold - 1, 0},
+		{"At threshold", accountLockThreshold, baseLoginLockout},
+		{"Doubles after threshold", accountLockThreshold + 2, 4 * baseLoginLockout},
+		{"Capped", accountLockThreshold + 50, maxLoginLockout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, loginLockout(tt.failures, accountLockThreshold))
+		})
+	}
+}
+
+func TestLoginKeys(t *testing.T) {
+	keys := loginKeys("User@Example.com", "10.0.0.1")
+	require.Equal(t, []loginKey{
+		{key: "email:user@example.com", threshold: accountLockThreshold},
+		{key: "ip:10.0.0.1", threshold: ipLockThreshold},
+	}, keys)
+
+	require.Len(t, loginKeys("user@example.com", ""), 1)
+}
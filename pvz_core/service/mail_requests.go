@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// Письма по запросам без авторизации: не больше 3 в час на адрес и 20 на
+	// IP; отклонённые запросы тоже считаются, поэтому лимит снимается после
+	// часа без запросов.
+	mailRequestsPerEmail = 3
+	mailRequestsPerIP    = 20
+	mailRequestsWindow   = time.Hour
+
+	mailRequestTimeout = 30 * time.Second
+)
+
+var ErrTooManyMailRequests = errors.New("слишком много запросов, попробуйте позже")
+
+// mailRequestKeys returns the throttling keys of a request for the flow, e.g.
+// "password_reset". They live in the login attempts storage next to the login
+// keys, so the flow name keeps them apart.
+func mailRequestKeys(flow, email, ip string) []loginKey {
+	keys := []loginKey{{key: flow + ":email:" + strings.ToLower(email), threshold: mailRequestsPerEmail}}
+
+	if ip != "" {
+		keys = append(keys, loginKey{key: flow + ":ip:" + ip, threshold: mailRequestsPerIP})
+	}
+
+	return keys
+}
+
+// throttleMailRequest counts the request for every key and rejects it if any
+// key is over its limit. The keys do not depend on whether the account
+// exists, so neither does the answer.
+func throttleMailRequest(ctx context.Context, attempts repository.LoginAttempts, log zerolog.Logger,
+	keys []loginKey, now time.Time) error {
+	var throttled bool
+
+	for _, key := range keys {
+		requests, err := attempts.RegisterLoginFailure(ctx, key.key, now, mailRequestsWindow)
+		if err != nil {
+			return err
+		}
+
+		if requests > key.threshold {
+			log.Warn().Str("key", key.key).Int("requests", requests).Msg("mail request throttled")
+			throttled = true
+		}
+	}
+
+	if throttled {
+		return ErrTooManyMailRequests
+	}
+
+	return nil
+}
+
+// handleMailRequest runs the account lookup, the token storage and the
+// sending after the response, so that the answer takes the same time whether
+// the email exists or not. The request context ends with the response, hence
+// a context of its own.
+func handleMailRequest(log zerolog.Logger, name string, fn func(ctx context.Context) error) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mailRequestTimeout)
+		defer cancel()
+
+		if err := fn(ctx); err != nil {
+			log.Error().Err(err).Str("request", name).Msg("failed to handle mail request")
+		}
+	}()
+}
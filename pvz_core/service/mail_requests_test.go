@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type loginAttemptsStub struct {
+	counters map[string]int
+}
+
+func (las *loginAttemptsStub) GetLockedUntil(ctx context.Context, keys []string) (*time.Time, error) {
+	return nil, nil
+}
+
+func (las *loginAttemptsStub) RegisterLoginFailure(ctx context.Context, key string, now time.Time,
+	window time.Duration) (int, error) {
+	las.counters[key]++
+	return las.counters[key], nil
+}
+
+func (las *loginAttemptsStub) LockLogin(ctx context.Context, key string, until time.Time) error {
+	return nil
+}
+
+func (las *loginAttemptsStub) ResetLoginFailures(ctx context.Context, key string) error {
+	delete(las.counters, key)
+	return nil
+}
+
+func (las *loginAttemptsStub) DeleteStaleLoginAttempts(ctx context.Context, before time.Time) error {
+	return nil
+}
+
+func TestMailRequestKeys(t *testing.T) {
+	keys := mailRequestKeys("password_reset", "User@Example.com", "10.0.0.1")
+	require.Equal(t, []loginKey{
+		{key: "password_reset:email:user@example.com", threshold: mailRequestsPerEmail},
+		{key: "password_reset:ip:10.0.0.1", threshold: mailRequestsPerIP},
+	}, keys)
+
+	require.Len(t, mailRequestKeys("password_reset", "user@example.com", ""), 1)
+}
+
+func TestThrottleMailRequest(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	attempts := &loginAttemptsStub{counters: map[string]int{}}
+
+	for i := 0; i < mailRequestsPerEmail; i++ {
+		err := throttleMailRequest(ctx, attempts, zerolog.Nop(),
+			mailRequestKeys("password_reset", "user@example.com", "10.0.0.1"), now)
+		require.NoError(t, err)
+	}
+
+	err := throttleMailRequest(ctx, attempts, zerolog.Nop(),
+		mailRequestKeys("password_reset", "USER@example.com", "10.0.0.2"), now)
+	require.ErrorIs(t, err, ErrTooManyMailRequests)
+
+	err = throttleMailRequest(ctx, attempts, zerolog.Nop(),
+		mailRequestKeys("password_reset", "other@example.com", "10.0.0.1"), now)
+	require.NoError(t, err)
+
+	err = throttleMailRequest(ctx, attempts, zerolog.Nop(),
+		mailRequestKeys("email_verification", "user@example.com", "10.0.0.1"), now)
+	require.NoError(t, err)
+}
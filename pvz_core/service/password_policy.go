@@ -0,0 +1,118 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/pkg/util"
+)
+
+// bcrypt учитывает только первые 72 байта пароля
+const maxPasswordBytes = 72
+
+var (
+	ErrPasswordTooShort = errors.New("пароль слишком короткий")
+	ErrPasswordTooLong  = errors.New("пароль длиннее 72 байт")
+	ErrPasswordTooWeak  = errors.New("пароль слишком простой")
+	ErrPasswordBreached = errors.New("пароль найден в списке скомпрометированных, выберите другой")
+)
+
+var passwordClassNames = map[string]string{
+	"lower":   "строчные буквы",
+	"upper":   "заглавные буквы",
+	"digit":   "цифры",
+	"special": "спецсимволы",
+}
+
+type passwordPolicy struct {
+	minLength       int
+	requiredClasses []string
+	denylist        map[string]struct{}
+	cost            int
+
+	// dummyHash is compared against when the email is unknown, so a missing
+	// user takes as long to reject as a wrong password.
+	dummyHash func() string
+}
+
+func newPasswordPolicy(cfg config.PasswordConfig) *passwordPolicy {
+	policy := &passwordPolicy{
+		minLength:       cfg.MinLength(),
+		requiredClasses: cfg.RequiredClasses(),
+		denylist:        make(map[string]struct{}, len(cfg.Denylist())),
+		cost:            cfg.BcryptCost(),
+	}
+
+	for _, password := range cfg.Denylist() {
+		policy.denylist[strings.ToLower(password)] = struct{}{}
+	}
+
+	policy.dummyHash = sync.OnceValue(func() string {
+		hash, _ := util.HashPassword("dummy-password-for-timing", policy.cost)
+		return hash
+	})
+
+	return policy
+}
+
+func (policy *passwordPolicy) validate(email, password string) error {
+	if err := validateData(email, password); err != nil {
+		return err
+	}
+
+	if length := len([]rune(password)); length < policy.minLength {
+		return fmt.Errorf("%w: минимум %d символов", ErrPasswordTooShort, policy.minLength)
+	}
+
+	if len(password) > maxPasswordBytes {
+		return ErrPasswordTooLong
+	}
+
+	var missing []string
+	for _, class := range policy.requiredClasses {
+		if !strings.ContainsFunc(password, passwordClassFunc(class)) {
+			missing = append(missing, passwordClassNames[class])
+		}
+	}
+
+	if len(missing) != 0 {
+		return fmt.Errorf("%w: добавьте %s", ErrPasswordTooWeak, strings.Join(missing, ", "))
+	}
+
+	if _, ok := policy.denylist[strings.ToLower(password)]; ok {
+		return ErrPasswordBreached
+	}
+
+	return nil
+}
+
+func (policy *passwordPolicy) hash(password string) (string, error) {
+	return util.HashPassword(password, policy.cost)
+}
+
+// needsRehash reports whether the hash was made with a cost other than the
+// configured one, so it is replaced on the next successful login.
+func (policy *passwordPolicy) needsRehash(hashedPassword string) bool {
+	cost, err := util.PasswordCost(hashedPassword)
+
+	return err == nil && cost != policy.cost
+}
+
+func passwordClassFunc(class string) func(rune) bool {
+	switch class {
+	case "lower":
+		return unicode.IsLower
+	case "upper":
+		return unicode.IsUpper
+	case "digit":
+		return unicode.IsDigit
+	default:
+		return func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+		}
+	}
+}
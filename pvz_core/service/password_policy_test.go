@@ -0,0 +1,81 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/pkg/util"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type passwordConfigStub struct {
+	minLength int
+	classes   []string
+	denylist  []string
+}
+
+func (stub passwordConfigStub) MinLength() int            { return stub.minLength }
+func (stub passwordConfigStub) RequiredClasses() []string { return stub.classes }
+func (stub passwordConfigStub) Denylist() []string        { return stub.denylist }
+func (stub passwordConfigStub) BcryptCost() int           { return bcrypt.MinCost }
+func (stub passwordConfigStub) ResetTTL() time.Duration   { return time.Hour }
+func (stub passwordConfigStub) ResetURL() string          { return "" }
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	policy := newPasswordPolicy(passwordConfigStub{
+		minLength: 8,
+		classes:   []string{"lower", "upper", "digit"},
+		denylist:  []string{"Password123"},
+	})
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{"Valid password", "Secur3Passw0rd", nil},
+		{"Cyrillic letters count", "Пароль2025", nil},
+		{"Empty password", "", ErrPasswordRequired},
+		{"Too short", "Ab1", ErrPasswordTooShort},
+		{"Too long", "Aa1" + strings.Repeat("x", 70), ErrPasswordTooLong},
+		{"Without digits", "SecurePassword", ErrPasswordTooWeak},
+		{"Without upper case", "secur3passw0rd", ErrPasswordTooWeak},
+		{"Breached password", "Password123", ErrPasswordBreached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.validate("user@mail.ru", tt.password)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPasswordPolicyValidateDenylistCase(t *testing.T) {
+	policy := newPasswordPolicy(passwordConfigStub{
+		minLength: 6,
+		denylist:  []string{"qwerty123"},
+	})
+
+	require.ErrorIs(t, policy.validate("user@mail.ru", "QWERTY123"), ErrPasswordBreached)
+	require.NoError(t, policy.validate("user@mail.ru", "qwerty1234"))
+}
+
+func TestPasswordPolicyNeedsRehash(t *testing.T) {
+	policy := newPasswordPolicy(passwordConfigStub{minLength: 1})
+
+	current, err := policy.hash("password")
+	require.NoError(t, err)
+	require.False(t, policy.needsRehash(current))
+
+	outdated, err := util.HashPassword("password", bcrypt.MinCost+1)
+	require.NoError(t, err)
+	require.True(t, policy.needsRehash(outdated))
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/mailer"
+	"github.com/MaksimovDenis/pvz_core/pkg/util"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidCurrentPassword = errors.New("неверный текущий пароль")
+	ErrPasswordUnchanged      = errors.New("новый пароль совпадает с текущим")
+	ErrInvalidResetToken      = errors.New("ссылка для сброса пароля недействительна или устарела")
+)
+
+type Passwords interface {
+	ChangePassword(ctx context.Context, req models.ChangePasswordReq) error
+	RequestPasswordReset(ctx context.Context, req models.PasswordResetReq) error
+	ResetPassword(ctx context.Context, req models.ResetPasswordReq) error
+}
+
+type PasswordService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+	txManager     db.TxManager
+	policy        *passwordPolicy
+	sender        mailer.Sender
+	resetTTL      time.Duration
+	resetURL      string
+}
+
+func newPasswordService(
+	appRepository repository.Repository,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	policy *passwordPolicy,
+	sender mailer.Sender,
+	cfg config.PasswordConfig,
+) *PasswordService {
+	return &PasswordService{
+		appRepository: appRepository,
+		log:           log,
+		txManager:     txManager,
+		policy:        policy,
+		sender:        sender,
+		resetTTL:      cfg.ResetTTL(),
+		resetURL:      cfg.ResetURL(),
+	}
+}
+
+// ChangePassword replaces the password of the authenticated user after
+// checking the current one. Every session of the user, the current one
+// included, is ended, so the client has to log in with the new password.
+func (pwd *PasswordService) ChangePassword(ctx context.Context, req models.ChangePasswordReq) error {
+	if req.CurrentPassword == "" {
+		return ErrPasswordRequired
+	}
+
+	user, err := pwd.appRepository.Authorization.GetUserCredentials(ctx, req.UserId)
+	if status.Code(err) == codes.NotFound {
+		return ErrAccountNotFound
+	} else if err != nil {
+		return errors.New("ошибка при смене пароля")
+	}
+
+	if err = util.CheckPassword(req.CurrentPassword, user.Password_hash); err != nil {
+		return ErrInvalidCurrentPassword
+	}
+
+	if req.NewPassword == req.CurrentPassword {
+		return ErrPasswordUnchanged
+	}
+
+	if err = pwd.policy.validate(user.Email, req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPwd, err := pwd.policy.hash(req.NewPassword)
+	if err != nil {
+		pwd.log.Error().Err(err).Msg("failed to hash password")
+		return errors.New("ошибка при смене пароля")
+	}
+
+	err = pwd.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		return pwd.storePassword(ctx, user.Id, hashedPwd)
+	})
+	if errors.Is(err, ErrAccountNotFound) {
+		return ErrAccountNotFound
+	} else if err != nil {
+		pwd.log.Error().Err(err).Msg("failed to change password")
+		return errors.New("ошибка при смене пароля")
+	}
+
+	return nil
+}
+
+// RequestPasswordReset emails a one-time reset token. It succeeds for unknown
+// and disabled accounts too and does the work after answering, so neither the
+// answer nor its timing reveals which emails exist. Requests are limited per
+// email and per client IP.
+func (pwd *PasswordService) RequestPasswordReset(ctx context.Context, req models.PasswordResetReq) error {
+	if req.Email == "" {
+		return ErrEmailRequired
+	}
+
+	err := throttleMailRequest(ctx, pwd.appRepository.LoginAttempts, pwd.log,
+		mailRequestKeys("password_reset", req.Email, req.IP), time.Now())
+	if errors.Is(err, ErrTooManyMailRequests) {
+		return ErrTooManyMailRequests
+	} else if err != nil {
+		return errors.New("ошибка при запросе сброса пароля")
+	}
+
+	handleMailRequest(pwd.log, "password_reset", func(ctx context.Context) error {
+		return pwd.sendPasswordReset(ctx, req.Email)
+	})
+
+	return nil
+}
+
+// ResetPassword sets a new password by a reset token. The token is single use,
+// and all sessions and login lockouts of the account are dropped.
+func (pwd *PasswordService) ResetPassword(ctx context.Context, req models.ResetPasswordReq) error {
+	if req.Token == "" {
+		return ErrInvalidResetToken
+	}
+
+	stored, err := pwd.appRepository.PasswordResets.GetPasswordResetToken(ctx, hashSecretToken(req.Token))
+	if status.Code(err) == codes.NotFound {
+		return ErrInvalidResetToken
+	} else if err != nil {
+		return errors.New("ошибка при сбросе пароля")
+	}
+
+	if stored.UsedAt != nil || stored.ExpiresAt.Before(time.Now()) {
+		return ErrInvalidResetToken
+	}
+
+	user, err := pwd.appRepository.Authorization.GetUserCredentials(ctx, stored.UserId)
+	if status.Code(err) == codes.NotFound {
+		return ErrInvalidResetToken
+	} else if err != nil {
+		return errors.New("ошибка при сбросе пароля")
+	}
+
+	if user.Disabled {
+		return ErrUserDisabled
+	}
+
+	if err = pwd.policy.validate(user.Email, req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPwd, err := pwd.policy.hash(req.NewPassword)
+	if err != nil {
+		pwd.log.Error().Err(err).Msg("failed to hash password")
+		return errors.New("ошибка при сбросе пароля")
+	}
+
+	err = pwd.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		used, errTx := pwd.appRepository.PasswordResets.UsePasswordResetToken(ctx, stored.Id)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !used {
+			return ErrInvalidResetToken
+		}
+
+		return pwd.storePassword(ctx, user.Id, hashedPwd)
+	})
+	switch {
+	case errors.Is(err, ErrInvalidResetToken), errors.Is(err, ErrAccountNotFound):
+		return ErrInvalidResetToken
+	case err != nil:
+		pwd.log.Error().Err(err).Msg("failed to reset password")
+		return errors.New("ошибка при сбросе пароля")
+	}
+
+	if err = pwd.appRepository.LoginAttempts.ResetLoginFailures(ctx, accountLoginKey(user.Email)); err != nil {
+		pwd.log.Error().Err(err).Msg("failed to reset login failures")
+	}
+
+	return nil
+}
+
+// storePassword saves the password hash, invalidates pending reset tokens and
+// revokes all refresh tokens of the user.
+func (pwd *PasswordService) storePassword(ctx context.Context, userId uuid.UUID, hashedPwd string) error {
+	errTx := pwd.appRepository.Authorization.UpdatePassword(ctx, userId, hashedPwd)
+	if status.Code(errTx) == codes.NotFound {
+		return ErrAccountNotFound
+	} else if errTx != nil {
+		return errTx
+	}
+
+	errTx = pwd.appRepository.PasswordResets.UseUserPasswordResetTokens(ctx, userId)
+	if errTx != nil {
+		return errTx
+	}
+
+	return pwd.appRepository.Tokens.RevokeAllUserRefreshTokens(ctx, userId)
+}
+
+// sendPasswordReset issues a reset token for an active account and emails it.
+func (pwd *PasswordService) sendPasswordReset(ctx context.Context, email string) error {
+	user, err := pwd.appRepository.Authorization.LoginUser(ctx, models.LoginUserReq{Email: email})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if user.Disabled {
+		pwd.log.Warn().Str("user_id", user.Id.String()).Msg("password reset requested for disabled user")
+		return nil
+	}
+
+	resetToken, err := newSecretToken()
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(pwd.resetTTL)
+
+	// Выданные ранее токены перестают действовать, остаётся только последний
+	err = pwd.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := pwd.appRepository.PasswordResets.UseUserPasswordResetTokens(ctx, user.Id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = pwd.appRepository.PasswordResets.CreatePasswordResetToken(ctx, models.PasswordResetTokenReq{
+			UserId:    user.Id,
+			TokenHash: hashSecretToken(resetToken),
+			ExpiresAt: expiresAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return pwd.appRepository.PasswordResets.DeleteExpiredPasswordResetTokens(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store password reset token: %w", err)
+	}
+
+	if err = pwd.sender.Send(ctx, pwd.resetMessage(user.Email, resetToken, expiresAt)); err != nil {
+		return fmt.Errorf("failed to send password reset email: %w", err)
+	}
+
+	return nil
+}
+
+func (pwd *PasswordService) resetMessage(email, resetToken string, expiresAt time.Time) mailer.Message {
+	var body string
+
+	if pwd.resetURL != "" {
+		body = fmt.Sprintf("Для сброса пароля перейдите по ссылке:\n%s?token=%s\n",
+			pwd.resetURL, url.QueryEscape(resetToken))
+	} else {
+		body = fmt.Sprintf("Код для сброса пароля:\n%s\n", resetToken)
+	}
+
+	body += fmt.Sprintf("\nДействует до %s. Если вы не запрашивали сброс пароля, проигнорируйте это письмо.",
+		expiresAt.Format("02.01.2006 15:04 MST"))
+
+	return mailer.Message{
+		To:      email,
+		Subject: "Сброс пароля",
+		Body:    body,
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrProductTypeCodeRequired  = errors.New("укажите код типа товара")
+	ErrProductTypeNameRequired  = errors.New("укажите название типа товара")
+	ErrProductTypeAlreadyExists = errors.New("тип товара уже существует")
+	ErrProductTypeNotFound      = errors.New("тип товара не найден")
+	ErrProductTypeInUse         = errors.New("тип товара используется в приёмках, удаление невозможно")
+	ErrProductTypeNoChanges     = errors.New("не указаны поля для изменения")
+)
+
+type ProductType interface {
+	CreateProductType(ctx context.Context, req models.CreateProductTypeReq) (models.ProductType, error)
+	GetProductTypes(ctx context.Context) ([]models.ProductType, error)
+	UpdateProductType(ctx context.Context, code string, req models.UpdateProductTypeReq) (models.ProductType, error)
+	DeleteProductType(ctx context.Context, code string) error
+}
+
+type ProductTypeService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+}
+
+func newProductTypeService(appRepository repository.Repository, log zerolog.Logger) *ProductTypeService {
+	return &ProductTypeService{
+		appRepository: appRepository,
+		log:           log,
+	}
+}
+
+func (pt *ProductTypeService) CreateProductType(ctx context.Context, req models.CreateProductTypeReq) (
+	models.ProductType, error) {
+	req.Code = strings.TrimSpace(req.Code)
+	if req.Code == "" {
+		return models.ProductType{}, ErrProductTypeCodeRequired
+	}
+
+	req.DisplayName = strings.TrimSpace(req.DisplayName)
+	if req.DisplayName == "" {
+		return models.ProductType{}, ErrProductTypeNameRequired
+	}
+
+	res, err := pt.appRepository.ProductTypes.CreateProductType(ctx, req)
+	if status.Code(err) == codes.AlreadyExists {
+		return res, ErrProductTypeAlreadyExists
+	} else if err != nil {
+		return res, errors.New("ошибка при создании типа товара")
+	}
+
+	return res, nil
+}
+
+func (pt *ProductTypeService) GetProductTypes(ctx context.Context) ([]models.ProductType, error) {
+	res, err := pt.appRepository.ProductTypes.GetProductTypes(ctx)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка типов товаров")
+	}
+
+	return res, nil
+}
+
+func (pt *ProductTypeService) UpdateProductType(ctx context.Context, code string, req models.UpdateProductTypeReq) (
+	models.ProductType, error) {
+	if req.DisplayName == nil && req.Fragile == nil && req.Oversized == nil && req.IsActive == nil {
+		return models.ProductType{}, ErrProductTypeNoChanges
+	}
+
+	if req.DisplayName != nil {
+		name := strings.TrimSpace(*req.DisplayName)
+		if name == "" {
+			return models.ProductType{}, ErrProductTypeNameRequired
+		}
+		req.DisplayName = &name
+	}
+
+	res, err := pt.appRepository.ProductTypes.UpdateProductType(ctx, code, req)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrProductTypeNotFound
+	} else if err != nil {
+		return res, errors.New("ошибка при изменении типа товара")
+	}
+
+	return res, nil
+}
+
+func (pt *ProductTypeService) DeleteProductType(ctx context.Context, code string) error {
+	err := pt.appRepository.ProductTypes.DeleteProductType(ctx, code)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return ErrProductTypeNotFound
+	case codes.FailedPrecondition:
+		return ErrProductTypeInUse
+	default:
+		return errors.New("ошибка при удалении типа товара")
+	}
+}
+
+func checkProductTypeAvailable(productType models.ProductType) error {
+	if !productType.IsActive {
+		return ErrProductTypeNotSupported
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductTypeValidation(t *testing.T) {
+	svc := &ProductTypeService{}
+	blank := "  "
+
+	t.Run("Create without code", func(t *testing.T) {
+		_, err := svc.CreateProductType(context.Background(), models.CreateProductTypeReq{DisplayName: "Мебель"})
+		require.ErrorIs(t, err, ErrProductTypeCodeRequired)
+	})
+
+	t.Run("Create without display name", func(t *testing.T) {
+		_, err := svc.CreateProductType(context.Background(), models.CreateProductTypeReq{Code: "мебель", DisplayName: blank})
+		require.ErrorIs(t, err, ErrProductTypeNameRequired)
+	})
+
+	t.Run("Update without fields", func(t *testing.T) {
+		_, err := svc.UpdateProductType(context.Background(), "мебель", models.UpdateProductTypeReq{})
+		require.ErrorIs(t, err, ErrProductTypeNoChanges)
+	})
+
+	t.Run("Update with blank display name", func(t *testing.T) {
+		_, err := svc.UpdateProductType(context.Background(), "мебель", models.UpdateProductTypeReq{DisplayName: &blank})
+		require.ErrorIs(t, err, ErrProductTypeNameRequired)
+	})
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/metrics"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrProductTypeNotSupported = errors.New("данный тип товара не поддерживается")
+	ErrNoActiveReception       = errors.New("неверный запрос или нет активной приемки")
+	ErrNoReception             = errors.New("небходимо создать новую приёмку товаров для данного ПВЗ")
+	ErrReceptionClosed         = errors.New("приёмка товаров в данном ПВЗ закрыта, необходимо открыть новую")
+	ErrNoProductsToDelete      = errors.New("в рамках текущей приёмки нет товаров для удаления")
+	ErrProductNotDeleted       = errors.New("неверный запрос, нет активной приемки или нет товаров для удаления")
+)
+
+type Product interface {
+	AddProduct(ctx context.Context, req models.CreateProductReq) (models.CreateProductRes, error)
+	DeleteProductByPVZId(ctx context.Context, userId, pvzId uuid.UUID) error
+}
+
+type ProductService struct {
+	appRepository repository.Repository
+	token         token.JWTMaker
+	log           zerolog.Logger
+	txManager     db.TxManager
+	metrics       *metrics.Metrics
+}
+
+func newProductService(
+	appRepository repository.Repository,
+	token token.JWTMaker,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	metrics *metrics.Metrics,
+) *ProductService {
+	return &ProductService{
+		appRepository: appRepository,
+		token:         token,
+		log:           log,
+		txManager:     txManager,
+		metrics:       metrics,
+	}
+}
+
+func (prd *ProductService) AddProduct(ctx context.Context, req models.CreateProductReq) (models.CreateProductRes, error) {
+	var res models.CreateProductRes
+
+	if err := checkPVZStaff(ctx, prd.appRepository, req.PvzId, req.UserId); err != nil {
+		return res, err
+	}
+
+	productType, err := prd.appRepository.ProductTypes.GetProductTypeByCode(ctx, req.ProductType)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrProductTypeNotSupported
+	} else if err != nil {
+		return res, errors.New("ошибка при добавлении товара")
+	}
+
+	if err := checkProductTypeAvailable(productType); err != nil {
+		return res, err
+	}
+
+	err = prd.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		recepRes, errTx := prd.appRepository.Receptions.GetLastReceptionByPVZId(ctx, req.PvzId)
+		if errTx != nil {
+			return ErrNoActiveReception
+		}
+
+		if recepRes.Status != "in_progress" {
+			return ErrNoActiveReception
+		}
+
+		req.ReceptionId = recepRes.Id
+
+		res, errTx = prd.appRepository.Products.AddProduct(ctx, req)
+		if errTx != nil {
+			return ErrNoActiveReception
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return res, err
+	}
+
+	prd.metrics.ProductsCountTotal.Inc()
+
+	return res, nil
+}
+
+func (prd *ProductService) DeleteProductByPVZId(ctx context.Context, userId, pvzId uuid.UUID) error {
+	if err := checkPVZStaff(ctx, prd.appRepository, pvzId, userId); err != nil {
+		return err
+	}
+
+	err := prd.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		recepRes, errTx := prd.appRepository.Receptions.GetLastReceptionByPVZId(ctx, pvzId)
+		if errTx != nil {
+			return ErrNoReception
+		}
+
+		if recepRes.Status == "close" {
+			return ErrReceptionClosed
+		}
+
+		productId, errTx := prd.appRepository.Products.GetLastProductIdByReceptionId(ctx, recepRes.Id)
+		if errTx != nil {
+			return ErrNoProductsToDelete
+		}
+
+		errTx = prd.appRepository.Products.DeleteProduct(ctx, productId)
+		if errTx != nil {
+			return ErrProductNotDeleted
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
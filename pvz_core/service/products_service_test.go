@@ -4,40 +4,30 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/MaksimovDenis/pvz_core/models"
 	"github.com/stretchr/testify/require"
 )
 
-func TestValidateProductType(t *testing.T) {
+func TestCheckProductTypeAvailable(t *testing.T) {
 	tests := []struct {
 		name        string
-		input       string
+		input       models.ProductType
 		wantErr     bool
 		expectedErr error
 	}{
 		{
-			name:    "valid electronics",
-			input:   "электроника",
+			name:    "active electronics",
+			input:   models.ProductType{Code: "электроника", IsActive: true},
 			wantErr: false,
 		},
 		{
-			name:    "valid clothing",
-			input:   "одежда",
+			name:    "active furniture",
+			input:   models.ProductType{Code: "мебель", IsActive: true, Oversized: true},
 			wantErr: false,
 		},
 		{
-			name:    "valid shoes",
-			input:   "обувь",
-			wantErr: false,
-		},
-		{
-			name:        "invalid product",
-			input:       "еда",
-			wantErr:     true,
-			expectedErr: errors.New("данный тип товара не поддерживается"),
-		},
-		{
-			name:        "empty string",
-			input:       "",
+			name:        "inactive product type",
+			input:       models.ProductType{Code: "обувь", IsActive: false},
 			wantErr:     true,
 			expectedErr: errors.New("данный тип товара не поддерживается"),
 		},
@@ -45,7 +35,7 @@ func TestValidateProductType(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateProductType(tt.input)
+			err := checkProductTypeAvailable(tt.input)
 			if tt.wantErr {
 				require.Error(t, err)
 				require.EqualError(t, err, tt.expectedErr.Error())
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidWeekday            = errors.New("день недели должен быть от 1 (понедельник) до 7 (воскресенье)")
+	ErrDuplicateWeekday          = errors.New("день недели указан в графике несколько раз")
+	ErrInvalidWorkingHours       = errors.New("время работы указывается в формате ЧЧ:ММ, открытие раньше закрытия")
+	ErrScheduleExceptionNotFound = errors.New("исключение в графике работы не найдено")
+	ErrPVZClosedNow              = errors.New("ПВЗ сейчас не работает, приёмка возможна только в рабочие часы")
+)
+
+const workingHoursLayout = "15:04"
+
+type PVZSchedule interface {
+	GetPVZSchedule(ctx context.Context, pvzId uuid.UUID) (models.PVZSchedule, error)
+	SetPVZSchedule(ctx context.Context, pvzId uuid.UUID, days []models.PVZScheduleDay) (models.PVZSchedule, error)
+	SetPVZScheduleException(ctx context.Context, pvzId uuid.UUID, exception models.PVZScheduleException) (
+		models.PVZScheduleException, error)
+	DeletePVZScheduleException(ctx context.Context, pvzId uuid.UUID, date time.Time) error
+}
+
+type PVZScheduleService struct {
+	appRepository  repository.Repository
+	log            zerolog.Logger
+	txManager      db.TxManager
+	scheduleConfig config.ScheduleConfig
+}
+
+func newPVZScheduleService(
+	appRepository repository.Repository,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	scheduleConfig config.ScheduleConfig,
+) *PVZScheduleService {
+	return &PVZScheduleService{
+		appRepository:  appRepository,
+		log:            log,
+		txManager:      txManager,
+		scheduleConfig: scheduleConfig,
+	}
+}
+
+// GetPVZSchedule returns the weekly schedule and the exceptions starting from today.
+func (sch *PVZScheduleService) GetPVZSchedule(ctx context.Context, pvzId uuid.UUID) (models.PVZSchedule, error) {
+	res := models.PVZSchedule{PVZId: pvzId}
+
+	_, err := sch.appRepository.PVZ.GetPVZById(ctx, pvzId)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrPVZNotFound
+	} else if err != nil {
+		return res, errors.New("ошибка при получении графика работы ПВЗ")
+	}
+
+	days, err := sch.appRepository.PVZSchedules.GetScheduleDays(ctx, []uuid.UUID{pvzId})
+	if err != nil {
+		return res, errors.New("ошибка при получении графика работы ПВЗ")
+	}
+
+	today := time.Now().In(sch.scheduleConfig.Location())
+
+	exceptions, err := sch.appRepository.PVZSchedules.GetScheduleExceptions(ctx, pvzId, today)
+	if err != nil {
+		return res, errors.New("ошибка при получении графика работы ПВЗ")
+	}
+
+	res.Days = days[pvzId]
+	res.Exceptions = exceptions
+
+	return res, nil
+}
+
+// SetPVZSchedule replaces the weekly schedule; an empty list removes it, and a
+// PVZ without a schedule has no working hours restrictions.
+func (sch *PVZScheduleService) SetPVZSchedule(ctx context.Context, pvzId uuid.UUID,
+	days []models.PVZScheduleDay) (models.PVZSchedule, error) {
+	if err := validateScheduleDays(days); err != nil {
+		return models.PVZSchedule{}, err
+	}
+
+	err := sch.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := sch.appRepository.PVZSchedules.ReplaceScheduleDays(ctx, pvzId, days)
+		if status.Code(errTx) == codes.NotFound {
+			return ErrPVZNotFound
+		} else if errTx != nil {
+			return errors.New("ошибка при изменении графика работы ПВЗ")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return models.PVZSchedule{}, err
+	}
+
+	return sch.GetPVZSchedule(ctx, pvzId)
+}
+
+func (sch *PVZScheduleService) SetPVZScheduleException(ctx context.Context, pvzId uuid.UUID,
+	exception models.PVZScheduleException) (models.PVZScheduleException, error) {
+	if err := validateScheduleException(&exception); err != nil {
+		return exception, err
+	}
+
+	res, err := sch.appRepository.PVZSchedules.UpsertScheduleException(ctx, pvzId, exception)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrPVZNotFound
+	} else if err != nil {
+		return res, errors.New("ошибка при изменении графика работы ПВЗ")
+	}
+
+	return res, nil
+}
+
+func (sch *PVZScheduleService) DeletePVZScheduleException(ctx context.Context, pvzId uuid.UUID, date time.Time) error {
+	err := sch.appRepository.PVZSchedules.DeleteScheduleException(ctx, pvzId, date)
+	if status.Code(err) == codes.NotFound {
+		return ErrScheduleExceptionNotFound
+	} else if err != nil {
+		return errors.New("ошибка при изменении графика работы ПВЗ")
+	}
+
+	return nil
+}
+
+func validateScheduleDays(days []models.PVZScheduleDay) error {
+	seen := make(map[int]bool, len(days))
+
+	for _, day := range days {
+		if day.Weekday < 1 || day.Weekday > 7 {
+			return ErrInvalidWeekday
+		}
+
+		if seen[day.Weekday] {
+			return ErrDuplicateWeekday
+		}
+		seen[day.Weekday] = true
+
+		if err := validateWorkingHours(day.OpenTime, day.CloseTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateScheduleException drops the hours of a closed day and requires them otherwise.
+func validateScheduleException(exception *models.PVZScheduleException) error {
+	if exception.IsClosed {
+		exception.OpenTime = nil
+		exception.CloseTime = nil
+
+		return nil
+	}
+
+	if exception.OpenTime == nil || exception.CloseTime == nil {
+		return ErrInvalidWorkingHours
+	}
+
+	return validateWorkingHours(*exception.OpenTime, *exception.CloseTime)
+}
+
+func validateWorkingHours(openTime, closeTime string) error {
+	open, err := time.Parse(workingHoursLayout, openTime)
+	if err != nil {
+		return ErrInvalidWorkingHours
+	}
+
+	closeAt, err := time.Parse(workingHoursLayout, closeTime)
+	if err != nil {
+		return ErrInvalidWorkingHours
+	}
+
+	if !open.Before(closeAt) {
+		return ErrInvalidWorkingHours
+	}
+
+	return nil
+}
+
+// openNow reports whether each PVZ with a weekly schedule is open at the given
+// local time; PVZ without a schedule are left out of the result.
+func openNow(ctx context.Context, appRepository repository.Repository, now time.Time,
+	pvzIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	res := make(map[uuid.UUID]bool, len(pvzIds))
+	if len(pvzIds) == 0 {
+		return res, nil
+	}
+
+	days, err := appRepository.PVZSchedules.GetScheduleDays(ctx, pvzIds)
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions, err := appRepository.PVZSchedules.GetScheduleExceptionsByDate(ctx, pvzIds, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Исключение на дату действует и без недельного графика
+	for _, pvzId := range pvzIds {
+		pvzDays, hasDays := days[pvzId]
+		exception, hasException := exceptions[pvzId]
+
+		switch {
+		case hasException:
+			res[pvzId] = isOpenAt(pvzDays, &exception, now)
+		case hasDays:
+			res[pvzId] = isOpenAt(pvzDays, nil, now)
+		}
+	}
+
+	return res, nil
+}
+
+// isOpenAt checks the local time against the exception for that date, if any,
+// and otherwise against the weekly schedule.
+func isOpenAt(days []models.PVZScheduleDay, exception *models.PVZScheduleException, at time.Time) bool {
+	clock := at.Format(workingHoursLayout)
+
+	if exception != nil {
+		if exception.IsClosed || exception.OpenTime == nil || exception.CloseTime == nil {
+			return false
+		}
+
+		return *exception.OpenTime <= clock && clock < *exception.CloseTime
+	}
+
+	weekday := int(at.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+
+	for _, day := range days {
+		if day.Weekday == weekday {
+			return day.OpenTime <= clock && clock < day.CloseTime
+		}
+	}
+
+	return false
+}
+
+// scheduleOpenNow is pvzOpenNow for a PVZ whose schedule for the date of now
+// came with the PVZ itself instead of from openNow. As there, an exception for
+// the date applies even without a weekly schedule.
+func scheduleOpenNow(pvzStatus string, schedule models.PVZScheduleOnDate, now time.Time) *bool {
+	var isOpen bool
+
+	switch {
+	case pvzStatus != pvzStatusActive:
+	case !schedule.HasSchedule && schedule.Exception == nil:
+		return nil
+	case schedule.Day != nil:
+		isOpen = isOpenAt([]models.PVZScheduleDay{*schedule.Day}, schedule.Exception, now)
+	default:
+		isOpen = isOpenAt(nil, schedule.Exception, now)
+	}
+
+	return &isOpen
+}
+
+// pvzOpenNow is nil for an active PVZ without a schedule, since its hours are unknown.
+func pvzOpenNow(pvzStatus string, open map[uuid.UUID]bool, pvzId uuid.UUID) *bool {
+	if pvzStatus != pvzStatusActive {
+		closed := false
+		return &closed
+	}
+
+	isOpen, ok := open[pvzId]
+	if !ok {
+		return nil
+	}
+
+	return &isOpen
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateScheduleDays(t *testing.T) {
+	tests := []struct {
+		name    string
+		days    []models.PVZScheduleDay
+		wantErr error
+	}{
+		{
+			name: "Empty schedule",
+		},
+		{
+			name: "Valid schedule",
+			days: []models.PVZScheduleDay{
+				{Weekday: 1, OpenTime: "09:00", CloseTime: "21:00"},
+				{Weekday: 7, OpenTime: "10:00", CloseTime: "18:00"},
+			},
+		},
+		{
+			name:    "Weekday out of range",
+			days:    []models.PVZScheduleDay{{Weekday: 0, OpenTime: "09:00", CloseTime: "21:00"}},
+			wantErr: ErrInvalidWeekday,
+		},
+		{
+			name: "Duplicate weekday",
+			days: []models.PVZScheduleDay{
+				{Weekday: 2, OpenTime: "09:00", CloseTime: "21:00"},
+				{Weekday: 2, OpenTime: "10:00", CloseTime: "18:00"},
+			},
+			wantErr: ErrDuplicateWeekday,
+		},
+		{
+			name:    "Invalid time format",
+			days:    []models.PVZScheduleDay{{Weekday: 3, OpenTime: "9", CloseTime: "21:00"}},
+			wantErr: ErrInvalidWorkingHours,
+		},
+		{
+			name:    "Opens after closing",
+			days:    []models.PVZScheduleDay{{Weekday: 4, OpenTime: "21:00", CloseTime: "09:00"}},
+			wantErr: ErrInvalidWorkingHours,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScheduleDays(tt.days)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateScheduleException(t *testing.T) {
+	open, closeAt := "10:00", "16:00"
+
+	closed := models.PVZScheduleException{IsClosed: true, OpenTime: &open, CloseTime: &closeAt}
+	require.NoError(t, validateScheduleException(&closed))
+	require.Nil(t, closed.OpenTime)
+	require.Nil(t, closed.CloseTime)
+
+	shortDay := models.PVZScheduleException{OpenTime: &open, CloseTime: &closeAt}
+	require.NoError(t, validateScheduleException(&shortDay))
+
+	noHours := models.PVZScheduleException{OpenTime: &open}
+	require.ErrorIs(t, validateScheduleException(&noHours), ErrInvalidWorkingHours)
+}
+
+func TestIsOpenAt(t *testing.T) {
+	// 2025-04-07 — понедельник
+	monday := time.Date(2025, 4, 7, 12, 30, 0, 0, time.UTC)
+	sunday := time.Date(2025, 4, 13, 12, 30, 0, 0, time.UTC)
+
+	days := []models.PVZScheduleDay{
+		{Weekday: 1, OpenTime: "09:00", CloseTime: "21:00"},
+		{Weekday: 7, OpenTime: "13:00", CloseTime: "18:00"},
+	}
+
+	open, closeAt := "10:00", "12:00"
+
+	tests := []struct {
+		name      string
+		exception *models.PVZScheduleException
+		at        time.Time
+		want      bool
+	}{
+		{"Within weekly hours", nil, monday, true},
+		{"At closing time", nil, time.Date(2025, 4, 7, 21, 0, 0, 0, time.UTC), false},
+		{"Before opening", nil, sunday, false},
+		{"Day without schedule", nil, time.Date(2025, 4, 8, 12, 30, 0, 0, time.UTC), false},
+		{"Holiday", &models.PVZScheduleException{IsClosed: true}, monday, false},
+		{"Short day already closed", &models.PVZScheduleException{OpenTime: &open, CloseTime: &closeAt}, monday, false},
+		{"Extra hours on sunday", &models.PVZScheduleException{OpenTime: &open, CloseTime: &closeAt},
+			time.Date(2025, 4, 13, 11, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isOpenAt(days, tt.exception, tt.at))
+		})
+	}
+}
+
+func TestPVZOpenNow(t *testing.T) {
+	withSchedule, withoutSchedule := uuid.New(), uuid.New()
+	open := map[uuid.UUID]bool{withSchedule: true}
+
+	require.True(t, *pvzOpenNow(pvzStatusActive, open, withSchedule))
+	require.Nil(t, pvzOpenNow(pvzStatusActive, open, withoutSchedule))
+	require.False(t, *pvzOpenNow(pvzStatusSuspended, open, withSchedule))
+	require.False(t, *pvzOpenNow(pvzStatusClosed, nil, withoutSchedule))
+}
+
+func TestScheduleOpenNow(t *testing.T) {
+	monday := time.Date(2025, 4, 7, 12, 30, 0, 0, time.UTC)
+	day := models.PVZScheduleDay{Weekday: 1, OpenTime: "09:00", CloseTime: "21:00"}
+	holiday := models.PVZScheduleException{IsClosed: true}
+
+	require.True(t, *scheduleOpenNow(pvzStatusActive, models.PVZScheduleOnDate{HasSchedule: true, Day: &day}, monday))
+	require.False(t, *scheduleOpenNow(pvzStatusActive, models.PVZScheduleOnDate{HasSchedule: true}, monday))
+	require.False(t, *scheduleOpenNow(pvzStatusActive,
+		models.PVZScheduleOnDate{HasSchedule: true, Day: &day, Exception: &holiday}, monday))
+	require.Nil(t, scheduleOpenNow(pvzStatusActive, models.PVZScheduleOnDate{}, monday))
+	require.False(t, *scheduleOpenNow(pvzStatusActive, models.PVZScheduleOnDate{Exception: &holiday}, monday))
+	require.False(t, *scheduleOpenNow(pvzStatusSuspended, models.PVZScheduleOnDate{HasSchedule: true, Day: &day}, monday))
+}
@@ -5,13 +5,15 @@ import (
 	"math/rand"
 	"testing"
 
-	"github.com/MaksimovDenis/avito_pvz/internal/client/db/pg"
-	"github.com/MaksimovDenis/avito_pvz/internal/client/db/transaction"
-	"github.com/MaksimovDenis/avito_pvz/internal/metrics"
-	"github.com/MaksimovDenis/avito_pvz/internal/models"
-	"github.com/MaksimovDenis/avito_pvz/internal/repository"
-	pgcontainer "github.com/MaksimovDenis/avito_pvz/pkg/pg_container"
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/client/db/pg"
+	"github.com/MaksimovDenis/pvz_core/client/db/transaction"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/metrics"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/mailer"
+	pgcontainer "github.com/MaksimovDenis/pvz_core/pkg/pg_container"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
 	"github.com/docker/docker/api/types/container"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -21,6 +23,7 @@ import (
 // ИНТЕГРАЦИОННЫЙ ТЕСТ
 // Cоздает нового пользователя
 // Cоздает новый ПВЗ
+// Закрепляет пользователя за ПВЗ
 // Добавляет новую приёмку заказов
 // Добавляет 50 товаров в рамках текущей приёмки заказов
 // Закрывает приёмку заказов
@@ -55,7 +58,23 @@ func TestIntegration(t *testing.T) {
 	txManager := transaction.NewTransactionsManager(clientDb.DB())
 	metrics := metrics.New()
 
-	svc := NewService(*repo, clientDb, token, log, txManager, metrics)
+	scheduleConfig, err := config.NewScheduleConfig()
+	require.NoError(t, err)
+
+	passwordConfig, err := config.NewPasswordConfig()
+	require.NoError(t, err)
+
+	appConfig, err := config.NewAppConfig()
+	require.NoError(t, err)
+
+	twoFactorConfig, err := config.NewTwoFactorConfig()
+	require.NoError(t, err)
+
+	registrationConfig, err := config.NewRegistrationConfig()
+	require.NoError(t, err)
+
+	svc := NewService(*repo, clientDb, token, log, txManager, metrics, scheduleConfig,
+		passwordConfig, appConfig, twoFactorConfig, registrationConfig, mailer.NewLogSender(log))
 
 	userId, err := uuid.NewRandom()
 	require.NoError(t, err)
@@ -63,7 +82,7 @@ func TestIntegration(t *testing.T) {
 	newUserInfo := models.CreateUserReq{
 		Id:       userId,
 		Email:    "admin@mail.ru",
-		Password: "admin",
+		Password: "Adm1nPassword",
 		Role:     "employee",
 	}
 
@@ -80,6 +99,9 @@ func TestIntegration(t *testing.T) {
 
 	pvzId := *newPVZ.Id
 
+	_, err = svc.PVZStaff.AssignEmployee(ctx, pvzId, newUser.Id)
+	require.NoError(t, err)
+
 	newReception, err := svc.Reception.CreateReception(ctx, newUser.Id, pvzId)
 	require.NoError(t, err)
 
@@ -95,7 +117,7 @@ func TestIntegration(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	_, err = svc.Reception.CloseReceptionByPVZId(ctx, pvzId)
+	_, err = svc.Reception.CloseReceptionByPVZId(ctx, newUser.Id, pvzId)
 	require.NoError(t, err)
 }
 
@@ -0,0 +1,552 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/metrics"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/cursor"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrCityNotSupported = errors.New("в данном городе пока нет доступных ПВЗ")
+	ErrInvalidPageToken = errors.New("неверный токен страницы")
+	ErrInvalidDateRange = errors.New("дата начала периода позже даты окончания")
+	ErrInvalidPageSize  = errors.New("размер страницы должен быть от 1 до 100")
+
+	ErrInvalidReceptionStatus = errors.New("неверный статус приёмки")
+
+	ErrPVZNotFound         = errors.New("ПВЗ не найден")
+	ErrPVZNotActive        = errors.New("ПВЗ не работает, приёмка товаров невозможна")
+	ErrInvalidPVZStatus    = errors.New("неверный статус ПВЗ")
+	ErrPVZNoChanges        = errors.New("не указаны поля для изменения")
+	ErrPVZHasOpenReception = errors.New("в ПВЗ есть незакрытая приёмка")
+	ErrPVZHasReceptions    = errors.New("в ПВЗ уже были приёмки, удаление невозможно, используйте деактивацию")
+
+	ErrInvalidCoordinates = errors.New("широта должна быть от -90 до 90, долгота от -180 до 180, координаты задаются вместе")
+	ErrInvalidRadius      = errors.New("радиус поиска должен быть от 1 до 50000 метров")
+)
+
+const (
+	pvzStatusActive    = "active"
+	pvzStatusSuspended = "suspended"
+	pvzStatusClosed    = "closed"
+)
+
+const (
+	defaultPVZPageSize = 30
+	maxPVZPageSize     = 100
+
+	defaultPageLimit = 10
+	maxPageLimit     = 30
+
+	defaultNearbyRadius = 5000
+	maxNearbyRadius     = 50000
+	defaultNearbyLimit  = 20
+	maxNearbyLimit      = 100
+)
+
+type PVZ interface {
+	CreatePVZ(ctx context.Context, req models.PVZReq) (models.PVZRes, error)
+	GetPVZ(ctx context.Context, req models.GetPVZReq) (models.GetPVZRes, error)
+	GetPVZList(ctx context.Context, req models.PVZListReq) (models.PVZListRes, error)
+	GetNearbyPVZ(ctx context.Context, req models.NearbyPVZReq) ([]models.NearbyPVZRes, error)
+	StreamPVZDetails(ctx context.Context, filter models.PVZFilter, send func(models.FullPVZRes) error) error
+	UpdatePVZ(ctx context.Context, pvzId uuid.UUID, req models.UpdatePVZReq) (models.PVZRes, error)
+	DeactivatePVZ(ctx context.Context, pvzId uuid.UUID) (models.PVZRes, error)
+	DeletePVZ(ctx context.Context, pvzId uuid.UUID) error
+}
+
+type PVZService struct {
+	appRepository  repository.Repository
+	token          token.JWTMaker
+	log            zerolog.Logger
+	txManager      db.TxManager
+	metrics        *metrics.Metrics
+	scheduleConfig config.ScheduleConfig
+}
+
+func newPVZService(
+	appRepository repository.Repository,
+	token token.JWTMaker,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	metrics *metrics.Metrics,
+	scheduleConfig config.ScheduleConfig,
+) *PVZService {
+	return &PVZService{
+		appRepository:  appRepository,
+		token:          token,
+		log:            log,
+		txManager:      txManager,
+		metrics:        metrics,
+		scheduleConfig: scheduleConfig,
+	}
+}
+
+func (pvz *PVZService) CreatePVZ(ctx context.Context, newPVZ models.PVZReq) (models.PVZRes, error) {
+	var res models.PVZRes
+
+	if err := validateCoordinates(newPVZ.Lat, newPVZ.Lon); err != nil {
+		return res, err
+	}
+
+	if err := pvz.checkCity(ctx, newPVZ.City); err != nil {
+		return res, err
+	}
+
+	if newPVZ.RegistrationDate == nil {
+		now := time.Now()
+		newPVZ.RegistrationDate = &now
+	}
+
+	res, err := pvz.appRepository.PVZ.CreatePVZ(ctx, newPVZ)
+	if err != nil {
+		return res, errors.New("ошибка при создании нового ПВЗ")
+	}
+
+	pvz.metrics.PvzCountTotal.Inc()
+
+	return res, nil
+}
+
+func (pvz *PVZService) GetPVZ(ctx context.Context, req models.GetPVZReq) (models.GetPVZRes, error) {
+	var res models.GetPVZRes
+
+	if err := validateGetPVZReq(req); err != nil {
+		return res, err
+	}
+
+	normalizePVZPage(&req)
+
+	// При переданном курсоре страница продолжается после него, номер страницы игнорируется
+	var after *cursor.Cursor
+	offset := (req.Page - 1) * req.Limit
+
+	if req.Cursor != "" {
+		c, err := cursor.Decode(req.Cursor)
+		if err != nil {
+			return res, ErrInvalidPageToken
+		}
+		after = &c
+		offset = 0
+	}
+
+	// Запрашиваем на один ПВЗ больше, чтобы понять, есть ли следующая страница
+	list, err := pvz.appRepository.PVZ.GetFullPVZInfo(ctx, req, after, req.Limit+1, offset)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка ПВЗ")
+	}
+
+	total, err := pvz.appRepository.PVZ.CountPVZ(ctx, req)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка ПВЗ")
+	}
+
+	if len(list) > req.Limit {
+		list = list[:req.Limit]
+		last := list[len(list)-1]
+
+		res.NextCursor = cursor.Encode(cursor.Cursor{
+			CreatedAt: *last.RegistrationDate,
+			Id:        *last.Id,
+		})
+
+		if after == nil {
+			res.NextPage = req.Page + 1
+		}
+	}
+
+	pvzIds := make([]uuid.UUID, len(list))
+	for idx, value := range list {
+		pvzIds[idx] = *value.Id
+	}
+
+	open, err := pvz.openNow(ctx, pvzIds)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка ПВЗ")
+	}
+
+	for idx := range list {
+		list[idx].IsOpenNow = pvzOpenNow(list[idx].Status, open, *list[idx].Id)
+	}
+
+	res.PVZ = list
+	res.Total = total
+
+	return res, nil
+}
+
+func validateGetPVZReq(req models.GetPVZReq) error {
+	if req.PVZStatus != "" {
+		if err := validatePVZStatus(req.PVZStatus); err != nil {
+			return err
+		}
+	}
+
+	if req.ReceptionStatus != "" && req.ReceptionStatus != "in_progress" &&
+		req.ReceptionStatus != "close" {
+		return ErrInvalidReceptionStatus
+	}
+
+	if req.StartDate != nil && req.EndTime != nil && req.StartDate.After(*req.EndTime) {
+		return ErrInvalidDateRange
+	}
+
+	return nil
+}
+
+func normalizePVZPage(req *models.GetPVZReq) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = defaultPageLimit
+	}
+
+	if req.Limit > maxPageLimit {
+		req.Limit = maxPageLimit
+	}
+}
+
+func (pvz *PVZService) GetPVZList(ctx context.Context, req models.PVZListReq) (models.PVZListRes, error) {
+	var res models.PVZListRes
+
+	if err := validatePVZListReq(&req); err != nil {
+		return res, err
+	}
+
+	var after *cursor.Cursor
+	if req.PageToken != "" {
+		c, err := cursor.Decode(req.PageToken)
+		if err != nil {
+			return res, ErrInvalidPageToken
+		}
+		after = &c
+	}
+
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	list, err := pvz.appRepository.PVZ.GetPVZList(ctx, req.PVZFilter, after, req.PageSize+1)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка ПВЗ")
+	}
+
+	if len(list) > req.PageSize {
+		list = list[:req.PageSize]
+		last := list[len(list)-1]
+		res.NextPageToken = cursor.Encode(cursor.Cursor{
+			CreatedAt: *last.RegistrationDate,
+			Id:        *last.Id,
+		})
+	}
+
+	if err := pvz.setListOpenNow(ctx, list); err != nil {
+		return res, errors.New("ошибка при получении списка ПВЗ")
+	}
+
+	res.PVZ = list
+
+	return res, nil
+}
+
+func (pvz *PVZService) GetNearbyPVZ(ctx context.Context, req models.NearbyPVZReq) ([]models.NearbyPVZRes, error) {
+	if err := validateNearbyPVZReq(&req); err != nil {
+		return nil, err
+	}
+
+	res, err := pvz.appRepository.PVZ.GetNearbyPVZ(ctx, req)
+	if err != nil {
+		return nil, errors.New("ошибка при поиске ближайших ПВЗ")
+	}
+
+	pvzIds := make([]uuid.UUID, len(res))
+	for idx, value := range res {
+		pvzIds[idx] = *value.PVZ.Id
+	}
+
+	open, err := pvz.openNow(ctx, pvzIds)
+	if err != nil {
+		return nil, errors.New("ошибка при поиске ближайших ПВЗ")
+	}
+
+	for idx := range res {
+		res[idx].PVZ.IsOpenNow = pvzOpenNow(res[idx].PVZ.Status, open, *res[idx].PVZ.Id)
+	}
+
+	return res, nil
+}
+
+func validateNearbyPVZReq(req *models.NearbyPVZReq) error {
+	if err := validateCoordinates(&req.Lat, &req.Lon); err != nil {
+		return err
+	}
+
+	if req.Radius == 0 {
+		req.Radius = defaultNearbyRadius
+	}
+
+	if req.Radius < 1 || req.Radius > maxNearbyRadius {
+		return ErrInvalidRadius
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = defaultNearbyLimit
+	}
+
+	if req.Limit > maxNearbyLimit {
+		req.Limit = maxNearbyLimit
+	}
+
+	return nil
+}
+
+// validateCoordinates checks that latitude and longitude are set together and lie in range.
+func validateCoordinates(lat, lon *float64) error {
+	if lat == nil && lon == nil {
+		return nil
+	}
+
+	if lat == nil || lon == nil {
+		return ErrInvalidCoordinates
+	}
+
+	if *lat < -90 || *lat > 90 || *lon < -180 || *lon > 180 {
+		return ErrInvalidCoordinates
+	}
+
+	return nil
+}
+
+func (pvz *PVZService) StreamPVZDetails(ctx context.Context, filter models.PVZFilter,
+	send func(models.FullPVZRes) error) error {
+	if err := validatePVZFilter(filter); err != nil {
+		return err
+	}
+
+	var sendErr error
+
+	now := time.Now().In(pvz.scheduleConfig.Location())
+
+	err := pvz.appRepository.PVZ.StreamFullPVZInfo(ctx, filter, now,
+		func(res models.FullPVZRes, schedule models.PVZScheduleOnDate) error {
+			res.IsOpenNow = scheduleOpenNow(res.Status, schedule, now)
+
+			sendErr = send(res)
+			return sendErr
+		})
+	if sendErr != nil {
+		return sendErr
+	}
+
+	if err != nil {
+		return errors.New("ошибка при получении списка ПВЗ")
+	}
+
+	return nil
+}
+
+func (pvz *PVZService) UpdatePVZ(ctx context.Context, pvzId uuid.UUID, req models.UpdatePVZReq) (models.PVZRes, error) {
+	var res models.PVZRes
+
+	if req.City == nil && req.Status == nil && req.Address == nil && req.Lat == nil && req.Lon == nil {
+		return res, ErrPVZNoChanges
+	}
+
+	if err := validateCoordinates(req.Lat, req.Lon); err != nil {
+		return res, err
+	}
+
+	if req.Status != nil {
+		if err := validatePVZStatus(*req.Status); err != nil {
+			return res, err
+		}
+	}
+
+	if req.City != nil {
+		if err := pvz.checkCity(ctx, *req.City); err != nil {
+			return res, err
+		}
+	}
+
+	// Уход из active по тем же правилам, что и у DeactivatePVZ
+	err := pvz.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		if req.Status != nil && *req.Status != pvzStatusActive {
+			if errTx = pvz.checkNoOpenReception(ctx, pvzId); errTx != nil {
+				return errTx
+			}
+		}
+
+		res, errTx = pvz.appRepository.PVZ.UpdatePVZ(ctx, pvzId, req)
+		if status.Code(errTx) == codes.NotFound {
+			return ErrPVZNotFound
+		} else if errTx != nil {
+			return errors.New("ошибка при изменении ПВЗ")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return res, err
+	}
+
+	open, err := pvz.openNow(ctx, []uuid.UUID{pvzId})
+	if err != nil {
+		return res, errors.New("ошибка при изменении ПВЗ")
+	}
+
+	res.IsOpenNow = pvzOpenNow(res.Status, open, pvzId)
+
+	return res, nil
+}
+
+// DeactivatePVZ closes the PVZ; a PVZ with an open reception has to finish it first.
+func (pvz *PVZService) DeactivatePVZ(ctx context.Context, pvzId uuid.UUID) (models.PVZRes, error) {
+	var res models.PVZRes
+
+	err := pvz.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		if errTx = pvz.checkNoOpenReception(ctx, pvzId); errTx != nil {
+			return errTx
+		}
+
+		closed := pvzStatusClosed
+
+		res, errTx = pvz.appRepository.PVZ.UpdatePVZ(ctx, pvzId, models.UpdatePVZReq{Status: &closed})
+		if status.Code(errTx) == codes.NotFound {
+			return ErrPVZNotFound
+		} else if errTx != nil {
+			return errors.New("ошибка при деактивации ПВЗ")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return res, err
+	}
+
+	// Закрытый ПВЗ не работает независимо от графика
+	res.IsOpenNow = pvzOpenNow(res.Status, nil, *res.Id)
+
+	return res, nil
+}
+
+// checkNoOpenReception is the rule for a PVZ leaving the active status: an
+// open reception has to be finished first. It locks the PVZ row, as
+// CreateReception does, so a reception can not be opened in between; it has to
+// run in a transaction.
+func (pvz *PVZService) checkNoOpenReception(ctx context.Context, pvzId uuid.UUID) error {
+	_, err := pvz.appRepository.PVZ.LockPVZById(ctx, pvzId)
+	if status.Code(err) == codes.NotFound {
+		return ErrPVZNotFound
+	} else if err != nil {
+		return errors.New("ошибка при изменении статуса ПВЗ")
+	}
+
+	lastReception, err := pvz.appRepository.Receptions.GetLastReceptionByPVZId(ctx, pvzId)
+	if err != nil {
+		return errors.New("ошибка при изменении статуса ПВЗ")
+	}
+
+	if lastReception.Status == "in_progress" {
+		return ErrPVZHasOpenReception
+	}
+
+	return nil
+}
+
+func (pvz *PVZService) DeletePVZ(ctx context.Context, pvzId uuid.UUID) error {
+	err := pvz.appRepository.PVZ.DeletePVZ(ctx, pvzId)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return ErrPVZNotFound
+	case codes.FailedPrecondition:
+		return ErrPVZHasReceptions
+	default:
+		return errors.New("ошибка при удалении ПВЗ")
+	}
+}
+
+func (pvz *PVZService) openNow(ctx context.Context, pvzIds []uuid.UUID) (map[uuid.UUID]bool, error) {
+	return openNow(ctx, pvz.appRepository, time.Now().In(pvz.scheduleConfig.Location()), pvzIds)
+}
+
+func (pvz *PVZService) setListOpenNow(ctx context.Context, list []models.PVZRes) error {
+	pvzIds := make([]uuid.UUID, len(list))
+	for idx, value := range list {
+		pvzIds[idx] = *value.Id
+	}
+
+	open, err := pvz.openNow(ctx, pvzIds)
+	if err != nil {
+		return err
+	}
+
+	for idx := range list {
+		list[idx].IsOpenNow = pvzOpenNow(list[idx].Status, open, *list[idx].Id)
+	}
+
+	return nil
+}
+
+func (pvz *PVZService) checkCity(ctx context.Context, name string) error {
+	city, err := pvz.appRepository.Cities.GetCityByName(ctx, name)
+	if status.Code(err) == codes.NotFound {
+		return ErrCityNotSupported
+	} else if err != nil {
+		return errors.New("ошибка при проверке города")
+	}
+
+	return checkCityAvailable(city, time.Now())
+}
+
+func validatePVZStatus(pvzStatus string) error {
+	if pvzStatus != pvzStatusActive && pvzStatus != pvzStatusSuspended &&
+		pvzStatus != pvzStatusClosed {
+		return ErrInvalidPVZStatus
+	}
+
+	return nil
+}
+
+func validatePVZListReq(req *models.PVZListReq) error {
+	if req.PageSize == 0 {
+		req.PageSize = defaultPVZPageSize
+	}
+
+	if req.PageSize < 0 || req.PageSize > maxPVZPageSize {
+		return ErrInvalidPageSize
+	}
+
+	return validatePVZFilter(req.PVZFilter)
+}
+
+func validatePVZFilter(filter models.PVZFilter) error {
+	if filter.Status != "" {
+		if err := validatePVZStatus(filter.Status); err != nil {
+			return err
+		}
+	}
+
+	if filter.StartDate != nil && filter.EndDate != nil && filter.StartDate.After(*filter.EndDate) {
+		return ErrInvalidDateRange
+	}
+
+	return nil
+}
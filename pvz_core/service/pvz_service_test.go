@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCityAvailable(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-24 * time.Hour)
+	future := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		city    models.City
+		wantErr bool
+	}{
+		{
+			name: "Active city without launch date",
+			city: models.City{Name: "Москва", IsActive: true},
+		},
+		{
+			name: "Active city already launched",
+			city: models.City{Name: "Казань", IsActive: true, LaunchDate: &past},
+		},
+		{
+			name:    "Active city not launched yet",
+			city:    models.City{Name: "Новосибирск", IsActive: true, LaunchDate: &future},
+			wantErr: true,
+		},
+		{
+			name:    "Inactive city",
+			city:    models.City{Name: "Санкт-Петербург", IsActive: false},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCityAvailable(tt.city, now)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrCityNotSupported)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePVZListReq(t *testing.T) {
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		req          models.PVZListReq
+		wantErr      error
+		wantPageSize int
+	}{
+		{
+			name:         "Default page size",
+			req:          models.PVZListReq{},
+			wantPageSize: defaultPVZPageSize,
+		},
+		{
+			name:         "Valid filters",
+			req:          models.PVZListReq{PVZFilter: models.PVZFilter{City: "Казань", StartDate: &start, EndDate: &end}, PageSize: 5},
+			wantPageSize: 5,
+		},
+		{
+			name:    "Negative page size",
+			req:     models.PVZListReq{PageSize: -1},
+			wantErr: ErrInvalidPageSize,
+		},
+		{
+			name:    "Page size too large",
+			req:     models.PVZListReq{PageSize: maxPVZPageSize + 1},
+			wantErr: ErrInvalidPageSize,
+		},
+		{
+			name:    "Start after end",
+			req:     models.PVZListReq{PVZFilter: models.PVZFilter{StartDate: &end, EndDate: &start}},
+			wantErr: ErrInvalidDateRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePVZListReq(&tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPageSize, tt.req.PageSize)
+		})
+	}
+}
+
+func TestNormalizePVZPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       models.GetPVZReq
+		wantPage  int
+		wantLimit int
+	}{
+		{"Defaults", models.GetPVZReq{}, 1, defaultPageLimit},
+		{"Negative values", models.GetPVZReq{Page: -2, Limit: -5}, 1, defaultPageLimit},
+		{"Custom values", models.GetPVZReq{Page: 3, Limit: 20}, 3, 20},
+		{"Limit above maximum", models.GetPVZReq{Page: 1, Limit: 100}, 1, maxPageLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalizePVZPage(&tt.req)
+			require.Equal(t, tt.wantPage, tt.req.Page)
+			require.Equal(t, tt.wantLimit, tt.req.Limit)
+		})
+	}
+}
+
+func TestValidateGetPVZReq(t *testing.T) {
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		req     models.GetPVZReq
+		wantErr error
+	}{
+		{
+			name: "Empty filters",
+			req:  models.GetPVZReq{},
+		},
+		{
+			name: "All filters",
+			req: models.GetPVZReq{
+				StartDate:       &start,
+				EndTime:         &end,
+				City:            "Москва",
+				ReceptionStatus: "close",
+				ProductType:     "обувь",
+			},
+		},
+		{
+			name:    "Unknown reception status",
+			req:     models.GetPVZReq{ReceptionStatus: "open"},
+			wantErr: ErrInvalidReceptionStatus,
+		},
+		{
+			name:    "Start after end",
+			req:     models.GetPVZReq{StartDate: &end, EndTime: &start},
+			wantErr: ErrInvalidDateRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGetPVZReq(tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidatePVZStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		pvzStatus string
+		wantErr   bool
+	}{
+		{"Active", "active", false},
+		{"Suspended", "suspended", false},
+		{"Closed", "closed", false},
+		{"Unknown", "deleted", true},
+		{"Empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePVZStatus(tt.pvzStatus)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidPVZStatus)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdatePVZValidation(t *testing.T) {
+	svc := &PVZService{}
+	invalid := "deleted"
+
+	_, err := svc.UpdatePVZ(context.Background(), uuid.New(), models.UpdatePVZReq{})
+	require.ErrorIs(t, err, ErrPVZNoChanges)
+
+	_, err = svc.UpdatePVZ(context.Background(), uuid.New(), models.UpdatePVZReq{Status: &invalid})
+	require.ErrorIs(t, err, ErrInvalidPVZStatus)
+}
+
+func TestValidateNearbyPVZReq(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        models.NearbyPVZReq
+		wantErr    error
+		wantRadius float64
+		wantLimit  int
+	}{
+		{
+			name:       "Defaults",
+			req:        models.NearbyPVZReq{Lat: 55.75, Lon: 37.62},
+			wantRadius: defaultNearbyRadius,
+			wantLimit:  defaultNearbyLimit,
+		},
+		{
+			name:       "Limit above maximum",
+			req:        models.NearbyPVZReq{Lat: 55.75, Lon: 37.62, Radius: 1000, Limit: 500},
+			wantRadius: 1000,
+			wantLimit:  maxNearbyLimit,
+		},
+		{
+			name:    "Latitude out of range",
+			req:     models.NearbyPVZReq{Lat: 91, Lon: 37.62},
+			wantErr: ErrInvalidCoordinates,
+		},
+		{
+			name:    "Longitude out of range",
+			req:     models.NearbyPVZReq{Lat: 55.75, Lon: -181},
+			wantErr: ErrInvalidCoordinates,
+		},
+		{
+			name:    "Negative radius",
+			req:     models.NearbyPVZReq{Lat: 55.75, Lon: 37.62, Radius: -1},
+			wantErr: ErrInvalidRadius,
+		},
+		{
+			name:    "Radius too large",
+			req:     models.NearbyPVZReq{Lat: 55.75, Lon: 37.62, Radius: maxNearbyRadius + 1},
+			wantErr: ErrInvalidRadius,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNearbyPVZReq(&tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRadius, tt.req.Radius)
+			require.Equal(t, tt.wantLimit, tt.req.Limit)
+		})
+	}
+}
+
+func TestValidateCoordinates(t *testing.T) {
+	lat, lon, outOfRange := 59.93, 30.31, 200.0
+
+	require.NoError(t, validateCoordinates(nil, nil))
+	require.NoError(t, validateCoordinates(&lat, &lon))
+	require.ErrorIs(t, validateCoordinates(&lat, nil), ErrInvalidCoordinates)
+	require.ErrorIs(t, validateCoordinates(nil, &lon), ErrInvalidCoordinates)
+	require.ErrorIs(t, validateCoordinates(&lat, &outOfRange), ErrInvalidCoordinates)
+}
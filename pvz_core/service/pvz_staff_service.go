@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrPVZAccessDenied         = errors.New("сотрудник не закреплён за данным ПВЗ")
+	ErrEmployeeNotFound        = errors.New("сотрудник не найден")
+	ErrUserNotEmployee         = errors.New("за ПВЗ можно закрепить только сотрудника")
+	ErrEmployeeAlreadyAssigned = errors.New("сотрудник уже закреплён за данным ПВЗ")
+	ErrEmployeeNotAssigned     = errors.New("сотрудник не найден среди закреплённых за ПВЗ")
+)
+
+type PVZStaff interface {
+	AssignEmployee(ctx context.Context, pvzId, userId uuid.UUID) ([]models.PVZStaff, error)
+	UnassignEmployee(ctx context.Context, pvzId, userId uuid.UUID) error
+	GetPVZStaff(ctx context.Context, pvzId uuid.UUID) ([]models.PVZStaff, error)
+}
+
+type PVZStaffService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+}
+
+func newPVZStaffService(appRepository repository.Repository, log zerolog.Logger) *PVZStaffService {
+	return &PVZStaffService{
+		appRepository: appRepository,
+		log:           log,
+	}
+}
+
+// AssignEmployee attaches the employee to the PVZ and returns its updated staff.
+func (stf *PVZStaffService) AssignEmployee(ctx context.Context, pvzId, userId uuid.UUID) ([]models.PVZStaff, error) {
+	user, err := stf.appRepository.Authorization.GetUserById(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrEmployeeNotFound
+	} else if err != nil {
+		return nil, errors.New("ошибка при назначении сотрудника")
+	}
+
+	if user.Role != roleEmployee {
+		return nil, ErrUserNotEmployee
+	}
+
+	err = stf.appRepository.PVZStaff.AssignEmployee(ctx, pvzId, userId)
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.AlreadyExists:
+		return nil, ErrEmployeeAlreadyAssigned
+	case codes.NotFound:
+		return nil, ErrPVZNotFound
+	default:
+		return nil, errors.New("ошибка при назначении сотрудника")
+	}
+
+	return stf.GetPVZStaff(ctx, pvzId)
+}
+
+func (stf *PVZStaffService) UnassignEmployee(ctx context.Context, pvzId, userId uuid.UUID) error {
+	err := stf.appRepository.PVZStaff.UnassignEmployee(ctx, pvzId, userId)
+	if status.Code(err) == codes.NotFound {
+		return ErrEmployeeNotAssigned
+	} else if err != nil {
+		return errors.New("ошибка при снятии сотрудника с ПВЗ")
+	}
+
+	return nil
+}
+
+func (stf *PVZStaffService) GetPVZStaff(ctx context.Context, pvzId uuid.UUID) ([]models.PVZStaff, error) {
+	_, err := stf.appRepository.PVZ.GetPVZById(ctx, pvzId)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrPVZNotFound
+	} else if err != nil {
+		return nil, errors.New("ошибка при получении сотрудников ПВЗ")
+	}
+
+	res, err := stf.appRepository.PVZStaff.GetPVZStaff(ctx, pvzId)
+	if err != nil {
+		return nil, errors.New("ошибка при получении сотрудников ПВЗ")
+	}
+
+	return res, nil
+}
+
+// checkPVZStaff allows employees to work only with the PVZ they are assigned
+// to. Service users of API keys are not assigned, the key restricts the PVZ.
+func checkPVZStaff(ctx context.Context, appRepository repository.Repository, pvzId, userId uuid.UUID) error {
+	assigned, err := appRepository.PVZStaff.IsAssigned(ctx, pvzId, userId)
+	if err != nil {
+		return errors.New("ошибка при проверке прав сотрудника")
+	}
+
+	if !assigned {
+		assigned, err = appRepository.APIKeys.CanAccessPVZ(ctx, userId, pvzId)
+		if err != nil {
+			return errors.New("ошибка при проверке прав сотрудника")
+		}
+	}
+
+	if !assigned {
+		return ErrPVZAccessDenied
+	}
+
+	return nil
+}
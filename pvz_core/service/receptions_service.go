@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/metrics"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/cursor"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidPVZId          = errors.New("неверный id ПВЗ")
+	ErrReceptionInProgress   = errors.New("невозможно начать новую приёмку товаров, пока не будет закрыта текущая")
+	ErrReceptionNotCreated   = errors.New("неверный запрос или есть незакрытая приемка")
+	ErrReceptionAlreadyClose = errors.New("данная приёмка уже закрыта")
+	ErrReceptionNotClosed    = errors.New("неверный запрос или приемка уже закрыта")
+	ErrReceptionNotFound     = errors.New("приёмка не найдена")
+)
+
+type Reception interface {
+	CreateReception(ctx context.Context, userId, pvzId uuid.UUID) (models.CreateReceptionRes, error)
+	CloseReceptionByPVZId(ctx context.Context, userId, pvzId uuid.UUID) (models.CreateReceptionRes, error)
+	GetReception(ctx context.Context, req models.GetReceptionReq) (models.GetReceptionRes, error)
+	GetReceptions(ctx context.Context, req models.GetReceptionsReq) (models.GetReceptionsRes, error)
+}
+
+type ReceptionService struct {
+	appRepository  repository.Repository
+	token          token.JWTMaker
+	log            zerolog.Logger
+	txManager      db.TxManager
+	metrics        *metrics.Metrics
+	scheduleConfig config.ScheduleConfig
+}
+
+func newReceptionService(
+	appRepository repository.Repository,
+	token token.JWTMaker,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	metrics *metrics.Metrics,
+	scheduleConfig config.ScheduleConfig,
+) *ReceptionService {
+	return &ReceptionService{
+		appRepository:  appRepository,
+		token:          token,
+		log:            log,
+		txManager:      txManager,
+		metrics:        metrics,
+		scheduleConfig: scheduleConfig,
+	}
+}
+
+func (rec *ReceptionService) CreateReception(ctx context.Context, userId, pvzId uuid.UUID) (models.CreateReceptionRes, error) {
+	var res models.CreateReceptionRes
+
+	if err := checkPVZStaff(ctx, rec.appRepository, pvzId, userId); err != nil {
+		return res, err
+	}
+
+	err := rec.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		// Блокировка строки ПВЗ не даёт закрыть его, пока открывается приёмка
+		pvz, errTx := rec.appRepository.PVZ.LockPVZById(ctx, pvzId)
+		if errTx != nil {
+			return ErrInvalidPVZId
+		}
+
+		if pvz.Status != pvzStatusActive {
+			return ErrPVZNotActive
+		}
+
+		if errTx = rec.checkWorkingHours(ctx, pvzId); errTx != nil {
+			return errTx
+		}
+
+		recepRes, errTx := rec.appRepository.Receptions.GetLastReceptionByPVZId(ctx, pvzId)
+		if errTx != nil {
+			return ErrInvalidPVZId
+		}
+
+		if recepRes.Status == "in_progress" {
+			return ErrReceptionInProgress
+		}
+
+		res, errTx = rec.appRepository.Receptions.CreateReception(ctx, userId, pvzId)
+		if errTx != nil {
+			return ErrReceptionNotCreated
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return res, err
+	}
+
+	rec.metrics.ReceptionCountTotal.Inc()
+
+	return res, nil
+}
+
+func (rec *ReceptionService) CloseReceptionByPVZId(ctx context.Context, userId, pvzId uuid.UUID) (
+	models.CreateReceptionRes, error) {
+	var res models.CreateReceptionRes
+
+	if err := checkPVZStaff(ctx, rec.appRepository, pvzId, userId); err != nil {
+		return res, err
+	}
+
+	err := rec.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+
+		recepRes, errTx := rec.appRepository.Receptions.GetLastReceptionByPVZId(ctx, pvzId)
+		if errTx != nil {
+			return ErrInvalidPVZId
+		}
+
+		if recepRes.Status != "in_progress" {
+			return ErrReceptionAlreadyClose
+		}
+
+		res, errTx = rec.appRepository.Receptions.CloseReceptionById(ctx, recepRes.Id, userId)
+		if errTx != nil {
+			return ErrReceptionNotClosed
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// GetReception returns the reception with the product counts by type and a
+// page of its products.
+func (rec *ReceptionService) GetReception(ctx context.Context, req models.GetReceptionReq) (models.GetReceptionRes, error) {
+	var res models.GetReceptionRes
+
+	req.Limit = normalizePageLimit(req.Limit)
+
+	var after *cursor.Cursor
+	if req.Cursor != "" {
+		c, err := cursor.Decode(req.Cursor)
+		if err != nil {
+			return res, ErrInvalidPageToken
+		}
+		after = &c
+	}
+
+	reception, err := rec.appRepository.Receptions.GetReceptionById(ctx, req.ReceptionId)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrReceptionNotFound
+	} else if err != nil {
+		return res, errors.New("ошибка при получении приёмки")
+	}
+
+	counts, err := rec.appRepository.Products.CountProductsByType(ctx, []uuid.UUID{reception.Id})
+	if err != nil {
+		return res, errors.New("ошибка при получении приёмки")
+	}
+
+	reception.ProductCounts = productCounts(counts, reception.Id)
+
+	// Запрашиваем на один товар больше, чтобы понять, есть ли следующая страница
+	products, err := rec.appRepository.Products.GetProductsByReceptionId(ctx, reception.Id, after, req.Limit+1)
+	if err != nil {
+		return res, errors.New("ошибка при получении товаров приёмки")
+	}
+
+	if len(products) > req.Limit {
+		products = products[:req.Limit]
+		last := products[len(products)-1]
+
+		res.NextCursor = cursor.Encode(cursor.Cursor{
+			CreatedAt: last.CreatedAt,
+			Id:        last.Id,
+		})
+	}
+
+	res.Reception = reception
+	res.Products = products
+
+	return res, nil
+}
+
+// GetReceptions returns the receptions of the PVZ, newest first, with the
+// product counts by type.
+func (rec *ReceptionService) GetReceptions(ctx context.Context, req models.GetReceptionsReq) (models.GetReceptionsRes, error) {
+	var res models.GetReceptionsRes
+
+	if err := validateGetReceptionsReq(&req); err != nil {
+		return res, err
+	}
+
+	var after *cursor.Cursor
+	if req.Cursor != "" {
+		c, err := cursor.Decode(req.Cursor)
+		if err != nil {
+			return res, ErrInvalidPageToken
+		}
+		after = &c
+	}
+
+	_, err := rec.appRepository.PVZ.GetPVZById(ctx, req.PvzId)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrPVZNotFound
+	} else if err != nil {
+		return res, errors.New("ошибка при получении приёмок")
+	}
+
+	list, err := rec.appRepository.Receptions.GetReceptions(ctx, req, after, req.Limit+1)
+	if err != nil {
+		return res, errors.New("ошибка при получении приёмок")
+	}
+
+	if len(list) > req.Limit {
+		list = list[:req.Limit]
+		last := list[len(list)-1]
+
+		res.NextCursor = cursor.Encode(cursor.Cursor{
+			CreatedAt: last.CreatedAt,
+			Id:        last.Id,
+		})
+	}
+
+	receptionIds := make([]uuid.UUID, len(list))
+	for idx, value := range list {
+		receptionIds[idx] = value.Id
+	}
+
+	counts, err := rec.appRepository.Products.CountProductsByType(ctx, receptionIds)
+	if err != nil {
+		return res, errors.New("ошибка при получении приёмок")
+	}
+
+	for idx := range list {
+		list[idx].ProductCounts = productCounts(counts, list[idx].Id)
+	}
+
+	res.Receptions = list
+
+	return res, nil
+}
+
+func validateGetReceptionsReq(req *models.GetReceptionsReq) error {
+	if req.Status != "" && req.Status != "in_progress" && req.Status != "close" {
+		return ErrInvalidReceptionStatus
+	}
+
+	if req.From != nil && req.To != nil && req.From.After(*req.To) {
+		return ErrInvalidDateRange
+	}
+
+	req.Limit = normalizePageLimit(req.Limit)
+
+	return nil
+}
+
+func normalizePageLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return defaultPageLimit
+	case limit > maxPageLimit:
+		return maxPageLimit
+	default:
+		return limit
+	}
+}
+
+// productCounts never returns nil, so receptions without products have an
+// empty object in the response.
+func productCounts(counts map[uuid.UUID]map[string]int, receptionId uuid.UUID) map[string]int {
+	if res, ok := counts[receptionId]; ok {
+		return res
+	}
+
+	return map[string]int{}
+}
+
+// checkWorkingHours rejects receptions outside the PVZ schedule when
+// RECEPTION_WORKING_HOURS_ONLY is on; PVZ without a schedule are not restricted.
+func (rec *ReceptionService) checkWorkingHours(ctx context.Context, pvzId uuid.UUID) error {
+	if !rec.scheduleConfig.ReceptionWorkingHoursOnly() {
+		return nil
+	}
+
+	now := time.Now().In(rec.scheduleConfig.Location())
+
+	open, err := openNow(ctx, rec.appRepository, now, []uuid.UUID{pvzId})
+	if err != nil {
+		return errors.New("ошибка при проверке графика работы ПВЗ")
+	}
+
+	if isOpen, ok := open[pvzId]; ok && !isOpen {
+		return ErrPVZClosedNow
+	}
+
+	return nil
+}
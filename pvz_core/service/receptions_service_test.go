@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGetReceptionsReq(t *testing.T) {
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		req       models.GetReceptionsReq
+		wantLimit int
+		wantErr   error
+	}{
+		{"Default limit", models.GetReceptionsReq{}, defaultPageLimit, nil},
+		{"Limit above max", models.GetReceptionsReq{Limit: maxPageLimit + 1}, maxPageLimit, nil},
+		{"Closed in period", models.GetReceptionsReq{Status: "close", From: &from, To: &to, Limit: 5}, 5, nil},
+		{"Unknown status", models.GetReceptionsReq{Status: "open"}, 0, ErrInvalidReceptionStatus},
+		{"Period reversed", models.GetReceptionsReq{From: &to, To: &from}, 0, ErrInvalidDateRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGetReceptionsReq(&tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantLimit, tt.req.Limit)
+		})
+	}
+}
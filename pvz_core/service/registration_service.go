@@ -0,0 +1,432 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/mailer"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// selfRegistrationRole is the only role available without an invite when
+// invite-only registration is turned off.
+const selfRegistrationRole = "employee"
+
+var (
+	ErrInviteRequired           = errors.New("регистрация возможна только по приглашению")
+	ErrInvalidInvite            = errors.New("приглашение недействительно или устарело")
+	ErrInviteEmailMismatch      = errors.New("приглашение выдано на другую почту")
+	ErrInviteNotFound           = errors.New("приглашение не найдено")
+	ErrEmailNotVerified         = errors.New("почта не подтверждена")
+	ErrInvalidVerificationToken = errors.New("ссылка для подтверждения почты недействительна или устарела")
+)
+
+type Registration interface {
+	Register(ctx context.Context, req models.CreateUserReq) (models.CreateUserRes, error)
+	VerifyEmail(ctx context.Context, verificationToken string) error
+	ResendEmailVerification(ctx context.Context, req models.ResendEmailVerificationReq) error
+	CreateInvite(ctx context.Context, req models.CreateInviteReq) (models.CreateInviteRes, error)
+	GetInvites(ctx context.Context) ([]models.Invite, error)
+	RevokeInvite(ctx context.Context, inviteId uuid.UUID) error
+}
+
+type RegistrationService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+	txManager     db.TxManager
+	auth          *AuthService
+	access        *AccessService
+	sender        mailer.Sender
+
+	inviteOnly      bool
+	inviteTTL       time.Duration
+	inviteURL       string
+	verificationTTL time.Duration
+	verificationURL string
+}
+
+func newRegistrationService(
+	appRepository repository.Repository,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	auth *AuthService,
+	access *AccessService,
+	sender mailer.Sender,
+	cfg config.RegistrationConfig,
+) *RegistrationService {
+	return &RegistrationService{
+		appRepository: appRepository,
+		log:           log,
+		txManager:     txManager,
+		auth:          auth,
+		access:        access,
+		sender:        sender,
+
+		inviteOnly:      cfg.InviteOnly(),
+		inviteTTL:       cfg.InviteTTL(),
+		inviteURL:       cfg.InviteURL(),
+		verificationTTL: cfg.VerificationTTL(),
+		verificationURL: cfg.VerificationURL(),
+	}
+}
+
+// Register creates an account by an invite, which fixes the role and the
+// city. Without an invite, if invite-only registration is turned off, only an
+// employee account can be created. The account can not log in until the
+// email is confirmed with VerifyEmail.
+func (reg *RegistrationService) Register(ctx context.Context, req models.CreateUserReq) (models.CreateUserRes, error) {
+	var (
+		res    models.CreateUserRes
+		invite *models.Invite
+	)
+
+	if req.InviteToken != "" {
+		stored, err := reg.appRepository.Invites.GetInviteByHash(ctx, hashSecretToken(req.InviteToken))
+		if status.Code(err) == codes.NotFound {
+			return res, ErrInvalidInvite
+		} else if err != nil {
+			return res, errors.New("ошибка при регистрации")
+		}
+
+		if err = checkInvite(stored, req.Email, time.Now()); err != nil {
+			return res, err
+		}
+
+		invite = &stored
+		req.Role = stored.Role
+		req.City = stored.City
+	} else {
+		if reg.inviteOnly || (req.Role != "" && req.Role != selfRegistrationRole) {
+			return res, ErrInviteRequired
+		}
+
+		req.Role = selfRegistrationRole
+	}
+
+	req, err := reg.auth.prepareUser(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	verificationToken, err := newSecretToken()
+	if err != nil {
+		reg.log.Error().Err(err).Msg("failed to generate email verification token")
+		return res, errors.New("ошибка при регистрации")
+	}
+
+	expiresAt := time.Now().Add(reg.verificationTTL)
+
+	err = reg.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var (
+			used  bool
+			errTx error
+		)
+
+		res, errTx = reg.appRepository.Authorization.CreateUser(ctx, req)
+		if status.Code(errTx) == codes.FailedPrecondition {
+			return ErrCityNotSupported
+		} else if errTx != nil {
+			return errTx
+		}
+
+		if invite != nil {
+			used, errTx = reg.appRepository.Invites.UseInvite(ctx, invite.Id, res.Id)
+			if errTx != nil {
+				return errTx
+			}
+
+			if !used {
+				return ErrInvalidInvite
+			}
+		}
+
+		return reg.appRepository.EmailVerifications.CreateEmailVerificationToken(ctx, models.EmailVerificationTokenReq{
+			UserId:    res.Id,
+			TokenHash: hashSecretToken(verificationToken),
+			ExpiresAt: expiresAt,
+		})
+	})
+	switch {
+	case errors.Is(err, ErrCityNotSupported):
+		return models.CreateUserRes{}, ErrCityNotSupported
+	case errors.Is(err, ErrInvalidInvite):
+		return models.CreateUserRes{}, ErrInvalidInvite
+	case err != nil:
+		reg.log.Error().Err(err).Msg("failed to register user")
+		return models.CreateUserRes{}, errors.New("ошибка при регистрации")
+	}
+
+	reg.log.Info().Str("user_id", res.Id.String()).Str("role", res.Role).
+		Bool("invited", invite != nil).Msg("user registered")
+
+	// Письмо можно запросить повторно, поэтому ошибка отправки не отменяет регистрацию
+	if err = reg.sender.Send(ctx, reg.verificationMessage(res.Email, verificationToken, expiresAt)); err != nil {
+		reg.log.Error().Err(err).Msg("failed to send email verification")
+	}
+
+	return res, nil
+}
+
+// VerifyEmail confirms the email of the account by a one-time token.
+func (reg *RegistrationService) VerifyEmail(ctx context.Context, verificationToken string) error {
+	if verificationToken == "" {
+		return ErrInvalidVerificationToken
+	}
+
+	stored, err := reg.appRepository.EmailVerifications.GetEmailVerificationToken(ctx, hashSecretToken(verificationToken))
+	if status.Code(err) == codes.NotFound {
+		return ErrInvalidVerificationToken
+	} else if err != nil {
+		return errors.New("ошибка при подтверждении почты")
+	}
+
+	if stored.UsedAt != nil || stored.ExpiresAt.Before(time.Now()) {
+		return ErrInvalidVerificationToken
+	}
+
+	err = reg.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		used, errTx := reg.appRepository.EmailVerifications.UseEmailVerificationToken(ctx, stored.Id)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !used {
+			return ErrInvalidVerificationToken
+		}
+
+		errTx = reg.appRepository.Authorization.SetEmailVerified(ctx, stored.UserId)
+		if status.Code(errTx) == codes.NotFound {
+			return ErrInvalidVerificationToken
+		} else if errTx != nil {
+			return errTx
+		}
+
+		return reg.appRepository.EmailVerifications.UseUserEmailVerificationTokens(ctx, stored.UserId)
+	})
+	if errors.Is(err, ErrInvalidVerificationToken) {
+		return ErrInvalidVerificationToken
+	} else if err != nil {
+		reg.log.Error().Err(err).Msg("failed to verify email")
+		return errors.New("ошибка при подтверждении почты")
+	}
+
+	return nil
+}
+
+// ResendEmailVerification emails a new verification token. Like a password
+// reset request it succeeds for unknown, disabled and already verified
+// accounts, does the work after answering and is limited per email and per
+// client IP, so neither the answer nor its timing reveals which emails exist.
+func (reg *RegistrationService) ResendEmailVerification(ctx context.Context, req models.ResendEmailVerificationReq) error {
+	if req.Email == "" {
+		return ErrEmailRequired
+	}
+
+	err := throttleMailRequest(ctx, reg.appRepository.LoginAttempts, reg.log,
+		mailRequestKeys("email_verification", req.Email, req.IP), time.Now())
+	if errors.Is(err, ErrTooManyMailRequests) {
+		return ErrTooManyMailRequests
+	} else if err != nil {
+		return errors.New("ошибка при запросе подтверждения почты")
+	}
+
+	handleMailRequest(reg.log, "email_verification", func(ctx context.Context) error {
+		return reg.sendEmailVerification(ctx, req.Email)
+	})
+
+	return nil
+}
+
+// sendEmailVerification issues a verification token for an active account
+// with an unconfirmed email and emails it.
+func (reg *RegistrationService) sendEmailVerification(ctx context.Context, email string) error {
+	user, err := reg.appRepository.Authorization.LoginUser(ctx, models.LoginUserReq{Email: email})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if user.Disabled || user.EmailVerified {
+		return nil
+	}
+
+	verificationToken, err := newSecretToken()
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(reg.verificationTTL)
+
+	err = reg.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := reg.appRepository.EmailVerifications.UseUserEmailVerificationTokens(ctx, user.Id)
+		if errTx != nil {
+			return errTx
+		}
+
+		errTx = reg.appRepository.EmailVerifications.CreateEmailVerificationToken(ctx, models.EmailVerificationTokenReq{
+			UserId:    user.Id,
+			TokenHash: hashSecretToken(verificationToken),
+			ExpiresAt: expiresAt,
+		})
+		if errTx != nil {
+			return errTx
+		}
+
+		return reg.appRepository.EmailVerifications.DeleteExpiredEmailVerificationTokens(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store email verification token: %w", err)
+	}
+
+	if err = reg.sender.Send(ctx, reg.verificationMessage(user.Email, verificationToken, expiresAt)); err != nil {
+		return fmt.Errorf("failed to send email verification: %w", err)
+	}
+
+	return nil
+}
+
+// CreateInvite issues an invite for a role and, when the invite is bound to
+// an email, sends it there. The plain token is returned only here.
+func (reg *RegistrationService) CreateInvite(ctx context.Context, req models.CreateInviteReq) (models.CreateInviteRes, error) {
+	var res models.CreateInviteRes
+
+	if req.Role == APIKeyRole {
+		return res, ErrInvalidRole
+	}
+
+	if req.Email != nil {
+		email := strings.TrimSpace(*req.Email)
+		if email == "" {
+			req.Email = nil
+		} else {
+			req.Email = &email
+		}
+	}
+
+	city, err := reg.access.checkRole(ctx, req.Role, req.City)
+	if err != nil {
+		return res, err
+	}
+
+	inviteToken, err := newSecretToken()
+	if err != nil {
+		reg.log.Error().Err(err).Msg("failed to generate invite token")
+		return res, errors.New("ошибка при создании приглашения")
+	}
+
+	res.Invite, err = reg.appRepository.Invites.CreateInvite(ctx, models.InviteReq{
+		Email:     req.Email,
+		Role:      req.Role,
+		City:      city,
+		TokenHash: hashSecretToken(inviteToken),
+		CreatedBy: req.CreatedBy,
+		ExpiresAt: time.Now().Add(reg.inviteTTL),
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return res, ErrCityNotSupported
+	} else if err != nil {
+		return res, errors.New("ошибка при создании приглашения")
+	}
+
+	reg.log.Info().Str("invite_id", res.Invite.Id.String()).Str("role", res.Invite.Role).
+		Str("created_by", req.CreatedBy.String()).Msg("invite created")
+
+	res.Token = inviteToken
+
+	// Токен уже возвращён создателю приглашения, его можно передать и без письма
+	if res.Invite.Email != nil {
+		err = reg.sender.Send(ctx, reg.inviteMessage(*res.Invite.Email, inviteToken, res.Invite.ExpiresAt))
+		if err != nil {
+			reg.log.Error().Err(err).Msg("failed to send invite email")
+		}
+	}
+
+	return res, nil
+}
+
+func (reg *RegistrationService) GetInvites(ctx context.Context) ([]models.Invite, error) {
+	res, err := reg.appRepository.Invites.GetInvites(ctx)
+	if err != nil {
+		return nil, errors.New("ошибка при получении приглашений")
+	}
+
+	return res, nil
+}
+
+func (reg *RegistrationService) RevokeInvite(ctx context.Context, inviteId uuid.UUID) error {
+	err := reg.appRepository.Invites.RevokeInvite(ctx, inviteId)
+	if status.Code(err) == codes.NotFound {
+		return ErrInviteNotFound
+	} else if err != nil {
+		return errors.New("ошибка при отзыве приглашения")
+	}
+
+	reg.log.Info().Str("invite_id", inviteId.String()).Msg("invite revoked")
+
+	return nil
+}
+
+func (reg *RegistrationService) verificationMessage(email, verificationToken string, expiresAt time.Time) mailer.Message {
+	var body string
+
+	if reg.verificationURL != "" {
+		body = fmt.Sprintf("Для подтверждения почты перейдите по ссылке:\n%s?token=%s\n",
+			reg.verificationURL, url.QueryEscape(verificationToken))
+	} else {
+		body = fmt.Sprintf("Код для подтверждения почты:\n%s\n", verificationToken)
+	}
+
+	body += fmt.Sprintf("\nДействует до %s. Если вы не регистрировались, проигнорируйте это письмо.",
+		expiresAt.Format("02.01.2006 15:04 MST"))
+
+	return mailer.Message{
+		To:      email,
+		Subject: "Подтверждение почты",
+		Body:    body,
+	}
+}
+
+func (reg *RegistrationService) inviteMessage(email, inviteToken string, expiresAt time.Time) mailer.Message {
+	var body string
+
+	if reg.inviteURL != "" {
+		body = fmt.Sprintf("Вас пригласили зарегистрироваться. Для регистрации перейдите по ссылке:\n%s?token=%s\n",
+			reg.inviteURL, url.QueryEscape(inviteToken))
+	} else {
+		body = fmt.Sprintf("Вас пригласили зарегистрироваться. Код приглашения:\n%s\n", inviteToken)
+	}
+
+	body += fmt.Sprintf("\nДействует до %s.", expiresAt.Format("02.01.2006 15:04 MST"))
+
+	return mailer.Message{
+		To:      email,
+		Subject: "Приглашение",
+		Body:    body,
+	}
+}
+
+// checkInvite reports whether the invite can still be used to register the
+// email.
+func checkInvite(invite models.Invite, email string, now time.Time) error {
+	if invite.UsedAt != nil || invite.RevokedAt != nil || !invite.ExpiresAt.After(now) {
+		return ErrInvalidInvite
+	}
+
+	if invite.Email != nil && !strings.EqualFold(*invite.Email, email) {
+		return ErrInviteEmailMismatch
+	}
+
+	return nil
+}
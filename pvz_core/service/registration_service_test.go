@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInvite(t *testing.T) {
+	now := time.Now()
+	email := "Employee@Example.com"
+
+	tests := []struct {
+		name    string
+		invite  models.Invite
+		email   string
+		wantErr error
+	}{
+		{"Active invite", models.Invite{ExpiresAt: now.Add(time.Hour)}, "employee@example.com", nil},
+		{"Email matches ignoring case", models.Invite{Email: &email, ExpiresAt: now.Add(time.Hour)},
+			"employee@example.com", nil},
+		{"Other email", models.Invite{Email: &email, ExpiresAt: now.Add(time.Hour)},
+			"other@example.com", ErrInviteEmailMismatch},
+		{"Expired", models.Invite{ExpiresAt: now.Add(-time.Minute)}, "employee@example.com", ErrInvalidInvite},
+		{"Used", models.Invite{ExpiresAt: now.Add(time.Hour), UsedAt: &now}, "employee@example.com", ErrInvalidInvite},
+		{"Revoked", models.Invite{ExpiresAt: now.Add(time.Hour), RevokedAt: &now}, "employee@example.com", ErrInvalidInvite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkInvite(tt.invite, tt.email, now)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/metrics"
+	"github.com/MaksimovDenis/pvz_core/pkg/mailer"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/rs/zerolog"
+)
+
+type Service struct {
+	Authorization
+	PVZ
+	Reception
+	Product
+	City
+	ProductType
+	PVZSchedule
+	PVZStaff
+	Access
+	Users
+	Passwords
+	TwoFactor
+	APIKeys
+	Registration
+}
+
+func NewService(repos repository.Repository,
+	client db.Client,
+	token token.JWTMaker,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	metrics *metrics.Metrics,
+	scheduleConfig config.ScheduleConfig,
+	passwordConfig config.PasswordConfig,
+	appConfig config.AppConfig,
+	twoFactorConfig config.TwoFactorConfig,
+	registrationConfig config.RegistrationConfig,
+	sender mailer.Sender) *Service {
+	access := newAccessService(repos, log)
+	passwords := newPasswordPolicy(passwordConfig)
+	auth := newAuthService(repos, token, log, txManager, access, passwords, appConfig.DummyLoginEnabled(), twoFactorConfig)
+
+	return &Service{
+		Authorization: auth,
+		PVZ:           newPVZService(repos, token, log, txManager, metrics, scheduleConfig),
+		Reception:     newReceptionService(repos, token, log, txManager, metrics, scheduleConfig),
+		Product:       newProductService(repos, token, log, txManager, metrics),
+		City:          newCityService(repos, log),
+		ProductType:   newProductTypeService(repos, log),
+		PVZSchedule:   newPVZScheduleService(repos, log, txManager, scheduleConfig),
+		PVZStaff:      newPVZStaffService(repos, log),
+		Access:        access,
+		Users:         newUserService(repos, log, txManager, access),
+		Passwords:     newPasswordService(repos, log, txManager, passwords, sender, passwordConfig),
+		TwoFactor:     auth,
+		APIKeys:       newAPIKeyService(repos, log, txManager),
+		Registration:  newRegistrationService(repos, log, txManager, auth, access, sender, registrationConfig),
+	}
+}
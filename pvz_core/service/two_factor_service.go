@@ -0,0 +1,428 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/totp"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	durationTwoFactorChallenge time.Duration = 5 * time.Minute
+
+	twoFactorMaxAttempts = 5
+	totpSkew             = 1
+
+	recoveryCodesCount    = 10
+	recoveryCodeLength    = 10
+	recoveryCodeAlphabet  = "abcdefghijkmnpqrstuvwxyz23456789"
+	recoveryCodeSeparator = "-"
+)
+
+var (
+	ErrTwoFactorCodeRequired   = errors.New("укажите код подтверждения")
+	ErrInvalidTwoFactorCode    = errors.New("неверный код подтверждения")
+	ErrInvalidChallenge        = errors.New("сессия входа недействительна или устарела, войдите заново")
+	ErrTwoFactorAlreadyEnabled = errors.New("двухфакторная аутентификация уже включена")
+	ErrTwoFactorNotEnrolled    = errors.New("двухфакторная аутентификация не настроена")
+	ErrTwoFactorNotEnabled     = errors.New("двухфакторная аутентификация не включена")
+	ErrTwoFactorRequired       = errors.New("для вашей роли требуется двухфакторная аутентификация")
+)
+
+type TwoFactor interface {
+	EnrollTwoFactor(ctx context.Context, userId uuid.UUID) (models.TwoFactorEnrollmentRes, error)
+	ConfirmTwoFactor(ctx context.Context, userId uuid.UUID, code string) error
+	DisableTwoFactor(ctx context.Context, userId uuid.UUID, code string) error
+	EnrollTwoFactorByChallenge(ctx context.Context, challengeToken string) (models.TwoFactorEnrollmentRes, error)
+	LoginTwoFactor(ctx context.Context, req models.TwoFactorLoginReq) (models.TokenPairRes, error)
+}
+
+// EnrollTwoFactor issues a new TOTP secret and recovery codes for the user.
+// Two-factor is turned on only after ConfirmTwoFactor proves that the
+// authenticator app produces valid codes.
+func (auth *AuthService) EnrollTwoFactor(ctx context.Context, userId uuid.UUID) (models.TwoFactorEnrollmentRes, error) {
+	var res models.TwoFactorEnrollmentRes
+
+	user, err := auth.appRepository.Authorization.GetUserById(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrAccountNotFound
+	} else if err != nil {
+		return res, errors.New("ошибка при подключении двухфакторной аутентификации")
+	}
+
+	return auth.enrollTwoFactor(ctx, user)
+}
+
+func (auth *AuthService) ConfirmTwoFactor(ctx context.Context, userId uuid.UUID, code string) error {
+	if code == "" {
+		return ErrTwoFactorCodeRequired
+	}
+
+	state, err := auth.appRepository.TwoFactor.GetTwoFactor(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return ErrAccountNotFound
+	} else if err != nil {
+		return errors.New("ошибка при подтверждении двухфакторной аутентификации")
+	}
+
+	switch {
+	case state.Enabled:
+		return ErrTwoFactorAlreadyEnabled
+	case state.Secret == nil:
+		return ErrTwoFactorNotEnrolled
+	}
+
+	valid, err := auth.verifyTwoFactorCode(ctx, userId, state, code)
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to verify two-factor code")
+		return errors.New("ошибка при подтверждении двухфакторной аутентификации")
+	}
+
+	if !valid {
+		return ErrInvalidTwoFactorCode
+	}
+
+	err = auth.appRepository.TwoFactor.EnableTwoFactor(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return ErrAccountNotFound
+	} else if err != nil {
+		auth.log.Error().Err(err).Msg("failed to enable two-factor")
+		return errors.New("ошибка при подтверждении двухфакторной аутентификации")
+	}
+
+	return nil
+}
+
+// DisableTwoFactor turns two-factor off after checking a current TOTP or
+// recovery code. Roles that require two-factor can not turn it off.
+func (auth *AuthService) DisableTwoFactor(ctx context.Context, userId uuid.UUID, code string) error {
+	if code == "" {
+		return ErrTwoFactorCodeRequired
+	}
+
+	user, err := auth.appRepository.Authorization.GetUserById(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return ErrAccountNotFound
+	} else if err != nil {
+		return errors.New("ошибка при отключении двухфакторной аутентификации")
+	}
+
+	if auth.twoFactorRequired(user.Role) {
+		return ErrTwoFactorRequired
+	}
+
+	state, err := auth.appRepository.TwoFactor.GetTwoFactor(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return ErrAccountNotFound
+	} else if err != nil {
+		return errors.New("ошибка при отключении двухфакторной аутентификации")
+	}
+
+	if !state.Enabled {
+		return ErrTwoFactorNotEnabled
+	}
+
+	valid, err := auth.verifyTwoFactorCode(ctx, userId, state, code)
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to verify two-factor code")
+		return errors.New("ошибка при отключении двухфакторной аутентификации")
+	}
+
+	if !valid {
+		return ErrInvalidTwoFactorCode
+	}
+
+	err = auth.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := auth.appRepository.TwoFactor.DisableTwoFactor(ctx, userId)
+		if errTx != nil {
+			return errTx
+		}
+
+		return auth.appRepository.TwoFactor.DeleteRecoveryCodes(ctx, userId)
+	})
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to disable two-factor")
+		return errors.New("ошибка при отключении двухфакторной аутентификации")
+	}
+
+	return nil
+}
+
+// EnrollTwoFactorByChallenge lets a user whose role requires two-factor set
+// it up during login, before any token pair is issued to them.
+func (auth *AuthService) EnrollTwoFactorByChallenge(ctx context.Context, challengeToken string) (models.TwoFactorEnrollmentRes, error) {
+	var res models.TwoFactorEnrollmentRes
+
+	challenge, err := auth.getTwoFactorChallenge(ctx, challengeToken)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := auth.appRepository.Authorization.GetUserById(ctx, challenge.UserId)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrInvalidChallenge
+	} else if err != nil {
+		return res, errors.New("ошибка при подключении двухфакторной аутентификации")
+	}
+
+	if user.Disabled {
+		return res, ErrUserDisabled
+	}
+
+	return auth.enrollTwoFactor(ctx, user)
+}
+
+// LoginTwoFactor completes a login started by LoginUser. A TOTP code for a
+// pending secret also turns two-factor on; recovery codes are accepted only
+// once two-factor is enabled. Wrong codes count as failed logins of the
+// account, so a fresh challenge does not give a fresh set of attempts.
+func (auth *AuthService) LoginTwoFactor(ctx context.Context, req models.TwoFactorLoginReq) (models.TokenPairRes, error) {
+	var res models.TokenPairRes
+
+	if req.Code == "" {
+		return res, ErrTwoFactorCodeRequired
+	}
+
+	challenge, err := auth.getTwoFactorChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return res, err
+	}
+
+	user, err := auth.appRepository.Authorization.GetUserById(ctx, challenge.UserId)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrInvalidChallenge
+	} else if err != nil {
+		return res, errors.New("ошибка при входе в систему")
+	}
+
+	if user.Disabled {
+		return res, ErrUserDisabled
+	}
+
+	now := time.Now()
+	keys := loginKeys(user.Email, "")
+
+	if err = auth.checkLoginLock(ctx, keys, now); err != nil {
+		return res, err
+	}
+
+	state, err := auth.appRepository.TwoFactor.GetTwoFactor(ctx, user.Id)
+	if status.Code(err) == codes.NotFound {
+		return res, ErrInvalidChallenge
+	} else if err != nil {
+		return res, errors.New("ошибка при входе в систему")
+	}
+
+	if state.Secret == nil {
+		return res, ErrTwoFactorNotEnrolled
+	}
+
+	valid, err := auth.verifyTwoFactorCode(ctx, user.Id, state, req.Code)
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to verify two-factor code")
+		return res, errors.New("ошибка при входе в систему")
+	}
+
+	if !valid {
+		auth.log.Warn().Str("user_id", user.Id.String()).Msg("invalid two-factor code")
+
+		if err = auth.appRepository.TwoFactor.FailTwoFactorChallenge(ctx, challenge.Id); err != nil {
+			auth.log.Error().Err(err).Msg("failed to register two-factor failure")
+		}
+
+		auth.registerLoginFailure(ctx, keys, now)
+
+		return res, ErrInvalidTwoFactorCode
+	}
+
+	err = auth.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		completed, errTx := auth.appRepository.TwoFactor.DeleteTwoFactorChallenge(ctx, challenge.Id)
+		if errTx != nil {
+			return errTx
+		}
+
+		if !completed {
+			return ErrInvalidChallenge
+		}
+
+		if !state.Enabled {
+			if errTx = auth.appRepository.TwoFactor.EnableTwoFactor(ctx, user.Id); errTx != nil {
+				return errTx
+			}
+		}
+
+		if errTx = auth.appRepository.TwoFactor.DeleteExpiredTwoFactorChallenges(ctx); errTx != nil {
+			return errTx
+		}
+
+		res, errTx = auth.generateTokenPair(ctx, user)
+
+		return errTx
+	})
+	if errors.Is(err, ErrInvalidChallenge) {
+		return res, ErrInvalidChallenge
+	} else if err != nil {
+		auth.log.Error().Err(err).Msg("failed to complete two-factor login")
+		return res, errors.New("ошибка при входе в систему")
+	}
+
+	auth.resetLoginFailures(ctx, user.Email, now)
+
+	return res, nil
+}
+
+// startTwoFactor creates the challenge LoginUser returns instead of a token
+// pair when the user has to pass or set up the second factor.
+func (auth *AuthService) startTwoFactor(ctx context.Context, user models.LoginUserRes) (models.TwoFactorChallengeRes, error) {
+	var res models.TwoFactorChallengeRes
+
+	challengeToken, err := newSecretToken()
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to generate two-factor challenge")
+		return res, errors.New("ошибка при входе в систему")
+	}
+
+	expiresAt := time.Now().Add(durationTwoFactorChallenge)
+
+	err = auth.appRepository.TwoFactor.CreateTwoFactorChallenge(ctx, models.TwoFactorChallengeReq{
+		UserId:    user.Id,
+		TokenHash: hashSecretToken(challengeToken),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to store two-factor challenge")
+		return res, errors.New("ошибка при входе в систему")
+	}
+
+	res.ChallengeToken = challengeToken
+	res.ExpiresAt = expiresAt
+	res.EnrollmentRequired = !user.TwoFactorEnabled
+
+	return res, nil
+}
+
+func (auth *AuthService) getTwoFactorChallenge(ctx context.Context, challengeToken string) (models.TwoFactorChallenge, error) {
+	if challengeToken == "" {
+		return models.TwoFactorChallenge{}, ErrInvalidChallenge
+	}
+
+	challenge, err := auth.appRepository.TwoFactor.GetTwoFactorChallenge(ctx, hashSecretToken(challengeToken))
+	if status.Code(err) == codes.NotFound {
+		return challenge, ErrInvalidChallenge
+	} else if err != nil {
+		return challenge, errors.New("ошибка при входе в систему")
+	}
+
+	if challenge.ExpiresAt.Before(time.Now()) || challenge.Attempts >= twoFactorMaxAttempts {
+		return challenge, ErrInvalidChallenge
+	}
+
+	return challenge, nil
+}
+
+func (auth *AuthService) enrollTwoFactor(ctx context.Context, user models.User) (models.TwoFactorEnrollmentRes, error) {
+	var res models.TwoFactorEnrollmentRes
+
+	if user.TwoFactorEnabled {
+		return res, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to generate totp secret")
+		return res, errors.New("ошибка при подключении двухфакторной аутентификации")
+	}
+
+	recoveryCodes, err := newRecoveryCodes()
+	if err != nil {
+		auth.log.Error().Err(err).Msg("failed to generate recovery codes")
+		return res, errors.New("ошибка при подключении двухфакторной аутентификации")
+	}
+
+	codeHashes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		codeHashes = append(codeHashes, hashSecretToken(normalizeRecoveryCode(code)))
+	}
+
+	err = auth.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := auth.appRepository.TwoFactor.SetTwoFactorSecret(ctx, user.Id, secret)
+		if status.Code(errTx) == codes.NotFound {
+			return ErrAccountNotFound
+		} else if errTx != nil {
+			return errTx
+		}
+
+		return auth.appRepository.TwoFactor.ReplaceRecoveryCodes(ctx, user.Id, codeHashes)
+	})
+	if errors.Is(err, ErrAccountNotFound) {
+		return res, ErrAccountNotFound
+	} else if err != nil {
+		auth.log.Error().Err(err).Msg("failed to store two-factor secret")
+		return res, errors.New("ошибка при подключении двухфакторной аутентификации")
+	}
+
+	res.Secret = secret
+	res.URI = totp.URI(auth.twoFactorIssuer, user.Email, secret)
+	res.RecoveryCodes = recoveryCodes
+
+	return res, nil
+}
+
+// verifyTwoFactorCode accepts a TOTP code, each time step at most once, and
+// after two-factor is enabled also an unused recovery code.
+func (auth *AuthService) verifyTwoFactorCode(ctx context.Context, userId uuid.UUID, state models.TwoFactorState, code string) (bool, error) {
+	if step, ok := totp.Validate(*state.Secret, code, time.Now(), totpSkew); ok {
+		return auth.appRepository.TwoFactor.UseTwoFactorStep(ctx, userId, step)
+	}
+
+	if !state.Enabled {
+		return false, nil
+	}
+
+	return auth.appRepository.TwoFactor.UseRecoveryCode(ctx, userId, hashSecretToken(normalizeRecoveryCode(code)))
+}
+
+func (auth *AuthService) twoFactorRequired(role string) bool {
+	_, ok := auth.twoFactorRoles[role]
+	return ok
+}
+
+func newRecoveryCodes() ([]string, error) {
+	res := make([]string, 0, recoveryCodesCount)
+	buf := make([]byte, recoveryCodeLength)
+
+	for n := 0; n < recoveryCodesCount; n++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+
+		var code strings.Builder
+		for i, b := range buf {
+			if i == recoveryCodeLength/2 {
+				code.WriteString(recoveryCodeSeparator)
+			}
+
+			code.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+		}
+
+		res = append(res, code.String())
+	}
+
+	return res, nil
+}
+
+// normalizeRecoveryCode lets users type a recovery code in any case and with
+// or without the separator.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, recoveryCodeSeparator, "")
+
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type twoFactorConfigStub struct {
+	roles []string
+}
+
+func (stub twoFactorConfigStub) RequiredRoles() []string { return stub.roles }
+func (stub twoFactorConfigStub) Issuer() string          { return "PVZ" }
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, err := newRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodesCount)
+
+	seen := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		require.Regexp(t, `^[a-z2-9]{5}-[a-z2-9]{5}$`, code)
+
+		_, duplicate := seen[code]
+		require.False(t, duplicate)
+
+		seen[code] = struct{}{}
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+	}{
+		{"As issued", "abcde-fgh23"},
+		{"Upper case", "ABCDE-FGH23"},
+		{"Without separator", "abcdefgh23"},
+		{"With spaces", " abcde fgh23 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, "abcdefgh23", normalizeRecoveryCode(tt.code))
+		})
+	}
+}
+
+func TestTwoFactorRequired(t *testing.T) {
+	auth := newAuthService(repository.Repository{}, token.JWTMaker{}, zerolog.Nop(), nil, nil, nil, false,
+		twoFactorConfigStub{roles: []string{"moderator"}})
+
+	require.True(t, auth.twoFactorRequired("moderator"))
+	require.False(t, auth.twoFactorRequired("employee"))
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const roleEmployee = "employee"
+
+var (
+	ErrAccountNotFound  = errors.New("пользователь не найден")
+	ErrUserDisabled     = errors.New("учётная запись отключена")
+	ErrUserNoChanges    = errors.New("не указаны поля для изменения")
+	ErrSelfModification = errors.New("нельзя изменить роль, отключить или удалить собственную учётную запись")
+)
+
+type Users interface {
+	GetUsers(ctx context.Context, req models.GetUsersReq) (models.GetUsersRes, error)
+	GetUser(ctx context.Context, userId uuid.UUID) (models.User, error)
+	UpdateUser(ctx context.Context, actorId, userId uuid.UUID, req models.UpdateUserReq) (models.User, error)
+	DeleteUser(ctx context.Context, actorId, userId uuid.UUID) error
+}
+
+type UserService struct {
+	appRepository repository.Repository
+	log           zerolog.Logger
+	txManager     db.TxManager
+	access        *AccessService
+}
+
+func newUserService(
+	appRepository repository.Repository,
+	log zerolog.Logger,
+	txManager db.TxManager,
+	access *AccessService,
+) *UserService {
+	return &UserService{
+		appRepository: appRepository,
+		log:           log,
+		txManager:     txManager,
+		access:        access,
+	}
+}
+
+func (usr *UserService) GetUsers(ctx context.Context, req models.GetUsersReq) (models.GetUsersRes, error) {
+	var res models.GetUsersRes
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = defaultPageLimit
+	}
+
+	if req.Limit > maxPageLimit {
+		req.Limit = maxPageLimit
+	}
+
+	// Запрашиваем на одного пользователя больше, чтобы понять, есть ли следующая страница
+	users, err := usr.appRepository.Authorization.GetUsers(ctx, req, req.Limit+1, (req.Page-1)*req.Limit)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка пользователей")
+	}
+
+	total, err := usr.appRepository.Authorization.CountUsers(ctx, req)
+	if err != nil {
+		return res, errors.New("ошибка при получении списка пользователей")
+	}
+
+	if len(users) > req.Limit {
+		users = users[:req.Limit]
+		res.NextPage = req.Page + 1
+	}
+
+	res.Users = users
+	res.Total = total
+
+	return res, nil
+}
+
+func (usr *UserService) GetUser(ctx context.Context, userId uuid.UUID) (models.User, error) {
+	user, err := usr.appRepository.Authorization.GetUserById(ctx, userId)
+	if status.Code(err) == codes.NotFound {
+		return user, ErrAccountNotFound
+	} else if err != nil {
+		return user, errors.New("ошибка при получении пользователя")
+	}
+
+	return user, nil
+}
+
+// UpdateUser changes the role, city or disabled flag of another user. Refresh
+// tokens are revoked when the role changes or the account gets disabled, so the
+// user has to log in again; an employee losing the role leaves the PVZ staff.
+func (usr *UserService) UpdateUser(ctx context.Context, actorId, userId uuid.UUID,
+	req models.UpdateUserReq) (models.User, error) {
+	var res models.User
+
+	if req.Role == nil && req.City == nil && req.Disabled == nil {
+		return res, ErrUserNoChanges
+	}
+
+	if actorId == userId {
+		return res, ErrSelfModification
+	}
+
+	err := usr.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, errTx := usr.GetUser(ctx, userId)
+		if errTx != nil {
+			return errTx
+		}
+
+		prevRole, prevDisabled := user.Role, user.Disabled
+
+		if req.Role != nil {
+			user.Role = *req.Role
+		}
+
+		if req.City != nil {
+			user.City = req.City
+		}
+
+		if req.Disabled != nil {
+			user.Disabled = *req.Disabled
+		}
+
+		user.City, errTx = usr.access.checkRole(ctx, user.Role, user.City)
+		if errTx != nil {
+			return errTx
+		}
+
+		res, errTx = usr.appRepository.Authorization.UpdateUser(ctx, user)
+		switch status.Code(errTx) {
+		case codes.OK:
+		case codes.NotFound:
+			return ErrAccountNotFound
+		case codes.FailedPrecondition:
+			return ErrCityNotSupported
+		default:
+			return errors.New("ошибка при изменении пользователя")
+		}
+
+		if prevRole == roleEmployee && res.Role != roleEmployee {
+			if errTx = usr.appRepository.PVZStaff.UnassignFromAllPVZ(ctx, userId); errTx != nil {
+				return errors.New("ошибка при изменении пользователя")
+			}
+		}
+
+		if res.Role != prevRole || (res.Disabled && !prevDisabled) {
+			if errTx = usr.appRepository.Tokens.RevokeAllUserRefreshTokens(ctx, userId); errTx != nil {
+				return errors.New("ошибка при изменении пользователя")
+			}
+		}
+
+		// Роль в access токене уже не та, поэтому выданные токены перестают действовать сразу
+		if res.Role != prevRole {
+			if errTx = usr.appRepository.Authorization.RevokeUserTokens(ctx, userId); errTx != nil {
+				return errors.New("ошибка при изменении пользователя")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+
+	return res, nil
+}
+
+// DeleteUser disables the account, hides it from the API and ends its sessions.
+func (usr *UserService) DeleteUser(ctx context.Context, actorId, userId uuid.UUID) error {
+	if actorId == userId {
+		return ErrSelfModification
+	}
+
+	return usr.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		errTx := usr.appRepository.Authorization.DeleteUser(ctx, userId)
+		if status.Code(errTx) == codes.NotFound {
+			return ErrAccountNotFound
+		} else if errTx != nil {
+			return errors.New("ошибка при удалении пользователя")
+		}
+
+		if errTx = usr.appRepository.PVZStaff.UnassignFromAllPVZ(ctx, userId); errTx != nil {
+			return errors.New("ошибка при удалении пользователя")
+		}
+
+		if errTx = usr.appRepository.Tokens.RevokeAllUserRefreshTokens(ctx, userId); errTx != nil {
+			return errors.New("ошибка при удалении пользователя")
+		}
+
+		return nil
+	})
+}
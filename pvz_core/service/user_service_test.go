@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateUserValidation(t *testing.T) {
+	users := newUserService(repository.Repository{}, zerolog.Nop(), nil, nil)
+	actorId, userId := uuid.New(), uuid.New()
+	disabled := true
+
+	_, err := users.UpdateUser(context.Background(), actorId, userId, models.UpdateUserReq{})
+	require.ErrorIs(t, err, ErrUserNoChanges)
+
+	_, err = users.UpdateUser(context.Background(), actorId, actorId, models.UpdateUserReq{Disabled: &disabled})
+	require.ErrorIs(t, err, ErrSelfModification)
+
+	require.ErrorIs(t, users.DeleteUser(context.Background(), actorId, actorId), ErrSelfModification)
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/MaksimovDenis/pvz_core/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var invalidArgumentErrors = []error{
+	service.ErrEmailRequired,
+	service.ErrPasswordRequired,
+	service.ErrEmailEqualsPassword,
+	service.ErrPasswordTooShort,
+	service.ErrPasswordTooLong,
+	service.ErrPasswordTooWeak,
+	service.ErrPasswordBreached,
+	service.ErrInvalidRole,
+	service.ErrCityRequired,
+	service.ErrCityNotSupported,
+	service.ErrTwoFactorCodeRequired,
+	service.ErrInvalidTwoFactorCode,
+	service.ErrTwoFactorNotEnrolled,
+	service.ErrInvalidInvite,
+	service.ErrInviteEmailMismatch,
+}
+
+var unauthenticatedErrors = []error{
+	service.ErrInvalidCredentials,
+	service.ErrInvalidRefreshToken,
+	service.ErrInvalidChallenge,
+}
+
+func toStatus(err error) error {
+	if errors.Is(err, service.ErrUserDisabled) || errors.Is(err, service.ErrTwoFactorRequired) ||
+		errors.Is(err, service.ErrEmailNotVerified) || errors.Is(err, service.ErrInviteRequired) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if errors.Is(err, service.ErrDummyLoginDisabled) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	if errors.Is(err, service.ErrTwoFactorAlreadyEnabled) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	if errors.Is(err, service.ErrTooManyLoginAttempts) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	for _, target := range invalidArgumentErrors {
+		if errors.Is(err, target) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	for _, target := range unauthenticatedErrors {
+		if errors.Is(err, target) {
+			return status.Error(codes.Unauthenticated, target.Error())
+		}
+	}
+
+	return status.Error(codes.Internal, "Internal server error")
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"net"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (hdl *Implementation) Login(ctx context.Context, req *pvz_v1.LoginRequest) (*pvz_v1.LoginResponse, error) {
+	res, err := hdl.authService.LoginUser(ctx, models.LoginUserReq{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+		IP:       peerIP(ctx),
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to login user")
+		return nil, toStatus(err)
+	}
+
+	if res.Challenge != nil {
+		return &pvz_v1.LoginResponse{
+			Challenge: &pvz_v1.TwoFactorChallenge{
+				ChallengeToken:     res.Challenge.ChallengeToken,
+				ExpiresAt:          timestamppb.New(res.Challenge.ExpiresAt),
+				EnrollmentRequired: res.Challenge.EnrollmentRequired,
+			},
+		}, nil
+	}
+
+	return &pvz_v1.LoginResponse{
+		Tokens: converterModelToTokenPair(res.Tokens),
+	}, nil
+}
+
+func (hdl *Implementation) DummyLogin(ctx context.Context, req *pvz_v1.DummyLoginRequest) (*pvz_v1.DummyLoginResponse, error) {
+	accessToken, err := hdl.authService.DummyLogin(ctx, req.GetRole())
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to dummy login")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.DummyLoginResponse{
+		Token: accessToken,
+	}, nil
+}
+
+func converterModelToTokenPair(data models.TokenPairRes) *pvz_v1.TokenPair {
+	return &pvz_v1.TokenPair{
+		AccessToken:  data.AccessToken,
+		RefreshToken: data.RefreshToken,
+	}
+}
+
+// peerIP returns the address of the client connection without the port.
+func peerIP(ctx context.Context) string {
+	client, ok := peer.FromContext(ctx)
+	if !ok || client.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(client.Addr.String())
+	if err != nil {
+		return client.Addr.String()
+	}
+
+	return host
+}
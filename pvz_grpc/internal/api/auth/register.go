@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+)
+
+func (hdl *Implementation) Register(ctx context.Context, req *pvz_v1.RegisterRequest) (*pvz_v1.RegisterResponse, error) {
+	user, err := hdl.registrationService.Register(ctx, models.CreateUserReq{
+		Email:       req.GetEmail(),
+		Password:    req.GetPassword(),
+		Role:        req.GetRole(),
+		InviteToken: req.GetInviteToken(),
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to create new user")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.RegisterResponse{
+		User: &pvz_v1.User{
+			Id:    user.Id.String(),
+			Email: user.Email,
+			Role:  user.Role,
+			City:  user.City,
+		},
+	}, nil
+}
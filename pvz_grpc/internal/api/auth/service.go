@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"github.com/MaksimovDenis/pvz_core/service"
+	desc "github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+	"github.com/rs/zerolog"
+)
+
+type Implementation struct {
+	desc.UnimplementedAuthServiceServer
+	authService         service.Authorization
+	twoFactorService    service.TwoFactor
+	registrationService service.Registration
+	log                 zerolog.Logger
+}
+
+func NewImplementation(appService *service.Service, log zerolog.Logger) *Implementation {
+	return &Implementation{
+		authService:         appService.Authorization,
+		twoFactorService:    appService.TwoFactor,
+		registrationService: appService.Registration,
+		log:                 log,
+	}
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/internal/interceptor"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (hdl *Implementation) RefreshToken(ctx context.Context, req *pvz_v1.RefreshTokenRequest) (*pvz_v1.RefreshTokenResponse, error) {
+	tokens, err := hdl.authService.RefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.RefreshTokenResponse{
+		Tokens: converterModelToTokenPair(tokens),
+	}, nil
+}
+
+func (hdl *Implementation) Logout(ctx context.Context, req *pvz_v1.LogoutRequest) (*pvz_v1.LogoutResponse, error) {
+	claims, ok := interceptor.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Неавторизован")
+	}
+
+	err := hdl.authService.Logout(ctx, models.LogoutReq{
+		UserId:         claims.ID,
+		TokenId:        claims.RegisteredClaims.ID,
+		TokenExpiresAt: claims.ExpiresAt.Time,
+		RefreshToken:   req.GetRefreshToken(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.LogoutResponse{}, nil
+}
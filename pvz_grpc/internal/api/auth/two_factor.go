@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+)
+
+func (hdl *Implementation) LoginTwoFactor(ctx context.Context, req *pvz_v1.LoginTwoFactorRequest) (*pvz_v1.LoginTwoFactorResponse, error) {
+	tokens, err := hdl.twoFactorService.LoginTwoFactor(ctx, models.TwoFactorLoginReq{
+		ChallengeToken: req.GetChallengeToken(),
+		Code:           req.GetCode(),
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to complete two-factor login")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.LoginTwoFactorResponse{
+		Tokens: converterModelToTokenPair(tokens),
+	}, nil
+}
+
+func (hdl *Implementation) EnrollTwoFactor(ctx context.Context, req *pvz_v1.EnrollTwoFactorRequest) (*pvz_v1.EnrollTwoFactorResponse, error) {
+	enrollment, err := hdl.twoFactorService.EnrollTwoFactorByChallenge(ctx, req.GetChallengeToken())
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to enroll two-factor")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.EnrollTwoFactorResponse{
+		Secret:        enrollment.Secret,
+		OtpauthUri:    enrollment.URI,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}, nil
+}
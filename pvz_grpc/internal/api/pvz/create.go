@@ -0,0 +1,38 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+)
+
+func (hdl *Implementation) CreatePVZ(ctx context.Context, req *pvz_v1.CreatePVZRequest) (*pvz_v1.CreatePVZResponse, error) {
+	claims, err := callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	newPVZ := models.PVZReq{
+		User_id: claims.ID,
+		City:    req.GetCity(),
+		Address: req.GetAddress(),
+		Lat:     req.Lat,
+		Lon:     req.Lon,
+	}
+
+	if req.GetRegistrationDate() != nil {
+		registrationDate := req.GetRegistrationDate().AsTime()
+		newPVZ.RegistrationDate = &registrationDate
+	}
+
+	res, err := hdl.pvzSecrvice.CreatePVZ(ctx, newPVZ)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to create pvz")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.CreatePVZResponse{
+		Pvz: converterModelToPVZRes(res),
+	}, nil
+}
@@ -0,0 +1,92 @@
+package loader
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/MaksimovDenis/pvz_grpc/internal/interceptor"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var invalidArgumentErrors = []error{
+	service.ErrCityNotSupported,
+	service.ErrProductTypeNotSupported,
+	service.ErrInvalidPVZId,
+	service.ErrReceptionNotCreated,
+	service.ErrInvalidPageToken,
+	service.ErrInvalidPageSize,
+	service.ErrInvalidDateRange,
+	service.ErrInvalidPVZStatus,
+	service.ErrInvalidCoordinates,
+}
+
+var failedPreconditionErrors = []error{
+	service.ErrReceptionInProgress,
+	service.ErrReceptionAlreadyClose,
+	service.ErrReceptionNotClosed,
+	service.ErrNoActiveReception,
+	service.ErrNoReception,
+	service.ErrReceptionClosed,
+	service.ErrNoProductsToDelete,
+	service.ErrProductNotDeleted,
+	service.ErrPVZNotActive,
+	service.ErrPVZClosedNow,
+}
+
+// toStatus maps business rule violations to the gRPC codes matching the HTTP
+// API's 4xx responses; anything else is reported as an internal error.
+func toStatus(err error) error {
+	if errors.Is(err, service.ErrPVZAccessDenied) || errors.Is(err, service.ErrPVZOutOfScope) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	for _, target := range invalidArgumentErrors {
+		if errors.Is(err, target) {
+			return status.Error(codes.InvalidArgument, target.Error())
+		}
+	}
+
+	for _, target := range failedPreconditionErrors {
+		if errors.Is(err, target) {
+			return status.Error(codes.FailedPrecondition, target.Error())
+		}
+	}
+
+	return status.Error(codes.Internal, "Internal server error")
+}
+
+func parsePVZId(pvzId string) (uuid.UUID, error) {
+	id, err := uuid.Parse(pvzId)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, service.ErrInvalidPVZId.Error())
+	}
+
+	return id, nil
+}
+
+func callerClaims(ctx context.Context) (*token.UserClaims, error) {
+	claims, ok := interceptor.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Неавторизован")
+	}
+
+	return claims, nil
+}
+
+// scopeCity narrows the requested city to the city scope of the caller.
+func scopeCity(ctx context.Context, city string) (string, error) {
+	scope := interceptor.CityScopeFromContext(ctx)
+	if scope == "" {
+		return city, nil
+	}
+
+	if city != "" && city != scope {
+		return "", status.Error(codes.PermissionDenied, service.ErrPVZOutOfScope.Error())
+	}
+
+	return scope, nil
+}
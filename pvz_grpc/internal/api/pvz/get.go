@@ -3,32 +3,82 @@ package loader
 import (
 	"context"
 
-	"github.com/MaksimovDenis/pvz_grpc/internal/models"
+	"github.com/MaksimovDenis/pvz_core/models"
 	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (hdl *Implementation) GetPVZList(ctx context.Context, req *pvz_v1.GetPVZListRequest) (*pvz_v1.GetPVZListResponse, error) {
-	list, err := hdl.pvzSecrvice.GetPVZ(ctx)
+	listReq := converterGetPVZListReqToModel(req)
+
+	city, err := scopeCity(ctx, listReq.City)
 	if err != nil {
-		hdl.log.Error().Err(err).Msgf("failed to get pvz list")
 		return nil, err
 	}
 
+	listReq.City = city
+
+	list, err := hdl.pvzSecrvice.GetPVZList(ctx, listReq)
+	if err != nil {
+		hdl.log.Error().Err(err).Msgf("failed to get pvz list")
+		return nil, toStatus(err)
+	}
+
 	return &pvz_v1.GetPVZListResponse{
-		Pvzs: converterToListPVZRes(list),
+		Pvzs:          converterToListPVZRes(list.PVZ),
+		NextPageToken: list.NextPageToken,
 	}, nil
 }
 
-func converterModelToPVZRes(data models.PVZ) *pvz_v1.PVZ {
-	return &pvz_v1.PVZ{
-		Id:               data.Id.String(),
-		RegistrationDate: timestamppb.New(data.RegistrationData),
-		City:             data.City,
+func converterGetPVZListReqToModel(req *pvz_v1.GetPVZListRequest) models.PVZListReq {
+	return models.PVZListReq{
+		PVZFilter: converterToPVZFilter(req.GetCity(), req.GetStatus(), req.GetStartDate(), req.GetEndDate()),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+}
+
+func converterToPVZFilter(city, pvzStatus string, startDate, endDate *timestamppb.Timestamp) models.PVZFilter {
+	res := models.PVZFilter{
+		City:   city,
+		Status: pvzStatus,
+	}
+
+	if startDate != nil {
+		start := startDate.AsTime()
+		res.StartDate = &start
 	}
+
+	if endDate != nil {
+		end := endDate.AsTime()
+		res.EndDate = &end
+	}
+
+	return res
+}
+
+func converterModelToPVZRes(data models.PVZRes) *pvz_v1.PVZ {
+	res := &pvz_v1.PVZ{
+		City:      data.City,
+		Status:    data.Status,
+		Address:   data.Address,
+		Lat:       data.Lat,
+		Lon:       data.Lon,
+		IsOpenNow: data.IsOpenNow,
+	}
+
+	if data.Id != nil {
+		res.Id = data.Id.String()
+	}
+
+	if data.RegistrationDate != nil {
+		res.RegistrationDate = timestamppb.New(*data.RegistrationDate)
+	}
+
+	return res
 }
 
-func converterToListPVZRes(list []models.PVZ) []*pvz_v1.PVZ {
+func converterToListPVZRes(list []models.PVZRes) []*pvz_v1.PVZ {
 	pvzList := make([]*pvz_v1.PVZ, len(list))
 
 	for idx, value := range list {
@@ -0,0 +1,59 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (hdl *Implementation) AddProduct(ctx context.Context, req *pvz_v1.AddProductRequest) (*pvz_v1.AddProductResponse, error) {
+	claims, err := callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzId, err := parsePVZId(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := hdl.productService.AddProduct(ctx, models.CreateProductReq{
+		UserId:      claims.ID,
+		PvzId:       pvzId,
+		ProductType: req.GetType(),
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to add a product")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.AddProductResponse{
+		Product: &pvz_v1.Product{
+			Id:          res.Id.String(),
+			DateTime:    timestamppb.New(res.DateTime),
+			Type:        res.ProductType,
+			ReceptionId: res.ReceptionId.String(),
+		},
+	}, nil
+}
+
+func (hdl *Implementation) DeleteLastProduct(ctx context.Context, req *pvz_v1.DeleteLastProductRequest) (*pvz_v1.DeleteLastProductResponse, error) {
+	claims, err := callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzId, err := parsePVZId(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = hdl.productService.DeleteProductByPVZId(ctx, claims.ID, pvzId); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to delete last product")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.DeleteLastProductResponse{}, nil
+}
@@ -0,0 +1,72 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const receptionClosed = "close"
+
+func (hdl *Implementation) CreateReception(ctx context.Context, req *pvz_v1.CreateReceptionRequest) (*pvz_v1.CreateReceptionResponse, error) {
+	claims, err := callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzId, err := parsePVZId(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := hdl.receptionService.CreateReception(ctx, claims.ID, pvzId)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to create new reception")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.CreateReceptionResponse{
+		Reception: converterModelToReceptionRes(res),
+	}, nil
+}
+
+func (hdl *Implementation) CloseLastReception(ctx context.Context, req *pvz_v1.CloseLastReceptionRequest) (*pvz_v1.CloseLastReceptionResponse, error) {
+	claims, err := callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzId, err := parsePVZId(req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := hdl.receptionService.CloseReceptionByPVZId(ctx, claims.ID, pvzId)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to close reception")
+		return nil, toStatus(err)
+	}
+
+	return &pvz_v1.CloseLastReceptionResponse{
+		Reception: converterModelToReceptionRes(res),
+	}, nil
+}
+
+func converterModelToReceptionRes(data models.CreateReceptionRes) *pvz_v1.Reception {
+	return &pvz_v1.Reception{
+		Id:       data.Id.String(),
+		DateTime: timestamppb.New(data.DateTime),
+		PvzId:    data.PvzId.String(),
+		Status:   converterToReceptionStatus(data.Status),
+	}
+}
+
+func converterToReceptionStatus(status string) pvz_v1.ReceptionStatus {
+	if status == receptionClosed {
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED
+	}
+
+	return pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
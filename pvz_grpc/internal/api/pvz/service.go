@@ -1,20 +1,24 @@
 package loader
 
 import (
-	"github.com/MaksimovDenis/pvz_grpc/internal/service"
+	"github.com/MaksimovDenis/pvz_core/service"
 	desc "github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
 	"github.com/rs/zerolog"
 )
 
 type Implementation struct {
 	desc.UnimplementedPVZServiceServer
-	pvzSecrvice service.PVZService
-	log         zerolog.Logger
+	pvzSecrvice      service.PVZ
+	receptionService service.Reception
+	productService   service.Product
+	log              zerolog.Logger
 }
 
-func NewImplementation(pvzSecrvice service.PVZService, log zerolog.Logger) *Implementation {
+func NewImplementation(appService *service.Service, log zerolog.Logger) *Implementation {
 	return &Implementation{
-		pvzSecrvice: pvzSecrvice,
-		log:         log,
+		pvzSecrvice:      appService.PVZ,
+		receptionService: appService.Reception,
+		productService:   appService.Product,
+		log:              log,
 	}
 }
@@ -0,0 +1,71 @@
+package loader
+
+import (
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (hdl *Implementation) StreamPVZDetails(req *pvz_v1.StreamPVZDetailsRequest, stream pvz_v1.PVZService_StreamPVZDetailsServer) error {
+	filter := converterToPVZFilter(req.GetCity(), req.GetStatus(), req.GetStartDate(), req.GetEndDate())
+
+	city, err := scopeCity(stream.Context(), filter.City)
+	if err != nil {
+		return err
+	}
+
+	filter.City = city
+
+	err = hdl.pvzSecrvice.StreamPVZDetails(stream.Context(), filter, func(data models.FullPVZRes) error {
+		return stream.Send(converterModelToPVZDetails(data))
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to stream pvz details")
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+func converterModelToPVZDetails(data models.FullPVZRes) *pvz_v1.StreamPVZDetailsResponse {
+	pvz := converterModelToPVZRes(models.PVZRes{
+		City:             data.City,
+		Id:               data.Id,
+		RegistrationDate: data.RegistrationDate,
+		Status:           data.Status,
+		Address:          data.Address,
+		Lat:              data.Lat,
+		Lon:              data.Lon,
+		IsOpenNow:        data.IsOpenNow,
+	})
+
+	receptions := make([]*pvz_v1.ReceptionDetails, len(data.Receptions))
+
+	for idx, reception := range data.Receptions {
+		products := make([]*pvz_v1.Product, len(reception.Products))
+
+		for i, product := range reception.Products {
+			products[i] = &pvz_v1.Product{
+				Id:          product.Id.String(),
+				DateTime:    timestamppb.New(product.CreatedAt),
+				Type:        product.ProductType,
+				ReceptionId: reception.Id.String(),
+			}
+		}
+
+		receptions[idx] = &pvz_v1.ReceptionDetails{
+			Reception: &pvz_v1.Reception{
+				Id:       reception.Id.String(),
+				DateTime: timestamppb.New(reception.CreatedAt),
+				PvzId:    pvz.GetId(),
+				Status:   converterToReceptionStatus(reception.Status),
+			},
+			Products: products,
+		}
+	}
+
+	return &pvz_v1.StreamPVZDetailsResponse{
+		Pvz:        pvz,
+		Receptions: receptions,
+	}
+}
@@ -3,10 +3,12 @@ package app
 import (
 	"context"
 	"net"
+	"net/http"
 
-	"github.com/MaksimovDenis/pvz_grpc/internal/closer"
-	"github.com/MaksimovDenis/pvz_grpc/internal/config"
+	"github.com/MaksimovDenis/pvz_core/closer"
+	"github.com/MaksimovDenis/pvz_core/config"
 	desc "github.com/MaksimovDenis/pvz_grpc/pkg/pvz_v1"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -36,6 +38,8 @@ func (app *App) Run() error {
 		closer.Wait()
 	}()
 
+	app.runMetricsServer()
+
 	return app.runGRPCServer()
 }
 
@@ -43,6 +47,7 @@ func (app *App) initDeps(ctx context.Context) error {
 	inits := []func(ctx context.Context) error{
 		app.initConfig,
 		app.initServiceProvider,
+		app.initDummyUsers,
 		app.initGRPCServer,
 	}
 
@@ -70,16 +75,53 @@ func (app *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initDummyUsers removes the seeded dummy users outside development, as their
+// passwords are public.
+func (app *App) initDummyUsers(ctx context.Context) error {
+	if app.serviceProvider.AppConfig().DummyLoginEnabled() {
+		return nil
+	}
+
+	return app.serviceProvider.AppService(ctx).DisableDummyUsers(ctx)
+}
+
 func (app *App) initGRPCServer(ctx context.Context) error {
-	app.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	authInterceptor := app.serviceProvider.AuthInterceptor(ctx)
+
+	app.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.ChainUnaryInterceptor(authInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream()),
+	)
 
 	reflection.Register(app.grpcServer)
 
 	desc.RegisterPVZServiceServer(app.grpcServer, app.serviceProvider.LoadImpl(ctx))
+	desc.RegisterAuthServiceServer(app.grpcServer, app.serviceProvider.AuthImpl(ctx))
 
 	return nil
 }
 
+// runMetricsServer exposes the business metrics shared with the HTTP service
+// when GRPC_METRICS_PORT is set.
+func (app *App) runMetricsServer() {
+	address := app.serviceProvider.GRPCConfig().MetricsAddress()
+	if address == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		log.Printf("Metrics server is running on %s", address)
+
+		if err := http.ListenAndServe(address, mux); err != nil {
+			log.Error().Err(err).Msg("metrics server stopped")
+		}
+	}()
+}
+
 func (app *App) runGRPCServer() error {
 	log.Printf("GRPC server is running on %s", app.serviceProvider.GRPCConfig().Address())
 
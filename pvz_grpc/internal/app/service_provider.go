@@ -4,32 +4,51 @@ import (
 	"context"
 	"os"
 
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/client/db/pg"
+	"github.com/MaksimovDenis/pvz_core/client/db/transaction"
+	"github.com/MaksimovDenis/pvz_core/closer"
+	"github.com/MaksimovDenis/pvz_core/config"
+	"github.com/MaksimovDenis/pvz_core/metrics"
+	"github.com/MaksimovDenis/pvz_core/pkg/mailer"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/MaksimovDenis/pvz_core/service"
+	auth "github.com/MaksimovDenis/pvz_grpc/internal/api/auth"
 	pvz "github.com/MaksimovDenis/pvz_grpc/internal/api/pvz"
-	db "github.com/MaksimovDenis/pvz_grpc/internal/client"
-	"github.com/MaksimovDenis/pvz_grpc/internal/client/db/pg"
-	"github.com/MaksimovDenis/pvz_grpc/internal/closer"
-	"github.com/MaksimovDenis/pvz_grpc/internal/config"
-	"github.com/MaksimovDenis/pvz_grpc/internal/repository"
-	pvzRepository "github.com/MaksimovDenis/pvz_grpc/internal/repository/pvz"
-	pvzService "github.com/MaksimovDenis/pvz_grpc/internal/service/pvz"
-
-	"github.com/MaksimovDenis/pvz_grpc/internal/service"
+	"github.com/MaksimovDenis/pvz_grpc/internal/interceptor"
+
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
 type serviceProvider struct {
-	pgConfig   config.PGConfig
-	grpcConfig config.GRPCConfig
+	pgConfig           config.PGConfig
+	grpcConfig         config.GRPCConfig
+	tokenConfig        config.TokenConfig
+	scheduleConfig     config.ScheduleConfig
+	passwordConfig     config.PasswordConfig
+	mailerConfig       config.MailerConfig
+	appConfig          config.AppConfig
+	twoFactorConfig    config.TwoFactorConfig
+	registrationConfig config.RegistrationConfig
 
 	dbClient      db.Client
-	pvzRepository repository.PVZRepository
+	txManager     db.TxManager
+	appRepository *repository.Repository
+
+	appService *service.Service
+
+	tokenMaker      *token.JWTMaker
+	authInterceptor *interceptor.AuthInterceptor
 
-	pvzService service.PVZService
+	mailer mailer.Sender
 
-	log zerolog.Logger
+	log     zerolog.Logger
+	metrics *metrics.Metrics
 
-	pvzImpl *pvz.Implementation
+	pvzImpl  *pvz.Implementation
+	authImpl *auth.Implementation
 }
 
 func newServiceProvider() *serviceProvider {
@@ -83,6 +102,117 @@ func (srv *serviceProvider) GRPCConfig() config.GRPCConfig {
 	return srv.grpcConfig
 }
 
+func (srv *serviceProvider) TokenConfig() config.TokenConfig {
+	if srv.tokenConfig == nil {
+		cfg, err := config.NewTokenConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get token config")
+		}
+
+		srv.tokenConfig = cfg
+	}
+
+	return srv.tokenConfig
+}
+
+func (srv *serviceProvider) Metrics() *metrics.Metrics {
+	if srv.metrics == nil {
+		srv.metrics = metrics.New()
+	}
+
+	return srv.metrics
+}
+
+func (srv *serviceProvider) ScheduleConfig() config.ScheduleConfig {
+	if srv.scheduleConfig == nil {
+		cfg, err := config.NewScheduleConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get schedule config")
+		}
+
+		srv.scheduleConfig = cfg
+	}
+
+	return srv.scheduleConfig
+}
+
+func (srv *serviceProvider) PasswordConfig() config.PasswordConfig {
+	if srv.passwordConfig == nil {
+		cfg, err := config.NewPasswordConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get password config")
+		}
+
+		srv.passwordConfig = cfg
+	}
+
+	return srv.passwordConfig
+}
+
+func (srv *serviceProvider) MailerConfig() config.MailerConfig {
+	if srv.mailerConfig == nil {
+		cfg, err := config.NewMailerConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get mailer config")
+		}
+
+		srv.mailerConfig = cfg
+	}
+
+	return srv.mailerConfig
+}
+
+func (srv *serviceProvider) AppConfig() config.AppConfig {
+	if srv.appConfig == nil {
+		cfg, err := config.NewAppConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get app config")
+		}
+
+		srv.appConfig = cfg
+	}
+
+	return srv.appConfig
+}
+
+func (srv *serviceProvider) TwoFactorConfig() config.TwoFactorConfig {
+	if srv.twoFactorConfig == nil {
+		cfg, err := config.NewTwoFactorConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get two-factor config")
+		}
+
+		srv.twoFactorConfig = cfg
+	}
+
+	return srv.twoFactorConfig
+}
+
+func (srv *serviceProvider) RegistrationConfig() config.RegistrationConfig {
+	if srv.registrationConfig == nil {
+		cfg, err := config.NewRegistrationConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get registration config")
+		}
+
+		srv.registrationConfig = cfg
+	}
+
+	return srv.registrationConfig
+}
+
+func (srv *serviceProvider) Mailer() mailer.Sender {
+	if srv.mailer == nil {
+		if srv.MailerConfig().File() != "" {
+			srv.mailer = mailer.NewFileSender(srv.MailerConfig().File())
+		} else {
+			srv.mailer = mailer.NewLogSender(srv.log.With().Str("module", "mailer").Logger())
+		}
+	}
+
+	return srv.mailer
+}
+
 func (srv *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if srv.dbClient == nil {
 		client, err := pg.New(ctx, srv.PGConfig().DSN())
@@ -106,28 +236,101 @@ func (srv *serviceProvider) DBClient(ctx context.Context) db.Client {
 	return srv.dbClient
 }
 
-func (srv *serviceProvider) LoaderRepository(ctx context.Context) repository.PVZRepository {
-	if srv.pvzRepository == nil {
-		srv.pvzRepository = pvzRepository.NewRepository(srv.DBClient(ctx), srv.log)
+func (srv *serviceProvider) TxManager(ctx context.Context) db.TxManager {
+	if srv.txManager == nil {
+		srv.txManager = transaction.NewTransactionsManager(srv.DBClient(ctx).DB())
 	}
 
-	return srv.pvzRepository
+	return srv.txManager
 }
 
-func (srv *serviceProvider) LoaderService(ctx context.Context) service.PVZService {
-	if srv.pvzService == nil {
-		srv.pvzService = pvzService.NewService(
-			srv.LoaderRepository(ctx),
-			srv.log)
+func (srv *serviceProvider) TokenMaker(ctx context.Context) *token.JWTMaker {
+	if srv.tokenMaker == nil {
+		cfg := srv.TokenConfig()
+
+		switch {
+		case cfg.KeysDir() != "":
+			keys, err := token.LoadKeySet(cfg.KeysDir(), cfg.ActiveKid(), cfg.RetiredKids())
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to load signing keys")
+			}
+
+			srv.tokenMaker = token.NewJWTMakerWithKeys(keys)
+		case cfg.JWKSURL() != "":
+			srv.tokenMaker = token.NewJWTMakerWithKeys(token.NewRemoteKeySet(cfg.JWKSURL()))
+		default:
+			srv.tokenMaker = token.NewJWTMaker(cfg.SecretKey())
+		}
+
+		srv.tokenMaker.SetRevocationList(srv.AppRepository(ctx).Tokens)
+		srv.tokenMaker.SetUserStatus(srv.AppRepository(ctx).Authorization)
+
+		if !srv.AppConfig().DummyLoginEnabled() {
+			srv.tokenMaker.DisableDummyTokens()
+		}
+	}
+
+	return srv.tokenMaker
+}
+
+func (srv *serviceProvider) AuthInterceptor(ctx context.Context) *interceptor.AuthInterceptor {
+	if srv.authInterceptor == nil {
+		srv.authInterceptor = interceptor.NewAuthInterceptor(srv.TokenMaker(ctx), srv.AppService(ctx).Access, srv.log)
+	}
+
+	return srv.authInterceptor
+}
+
+func (srv *serviceProvider) AppRepository(ctx context.Context) *repository.Repository {
+	if srv.appRepository == nil {
+		srv.appRepository = repository.NewRepository(
+			srv.DBClient(ctx),
+			srv.log.With().Str("module", "repository").Logger(),
+		)
+	}
+
+	return srv.appRepository
+}
+
+func (srv *serviceProvider) AppService(ctx context.Context) *service.Service {
+	if srv.appService == nil {
+		srv.appService = service.NewService(
+			*srv.AppRepository(ctx),
+			srv.DBClient(ctx),
+			*srv.TokenMaker(ctx),
+			srv.log.With().Str("module", "service").Logger(),
+			srv.TxManager(ctx),
+			srv.Metrics(),
+			srv.ScheduleConfig(),
+			srv.PasswordConfig(),
+			srv.AppConfig(),
+			srv.TwoFactorConfig(),
+			srv.RegistrationConfig(),
+			srv.Mailer(),
+		)
 	}
 
-	return srv.pvzService
+	return srv.appService
 }
 
 func (srv *serviceProvider) LoadImpl(ctx context.Context) *pvz.Implementation {
 	if srv.pvzImpl == nil {
-		srv.pvzImpl = pvz.NewImplementation(srv.LoaderService(ctx), srv.log)
+		srv.pvzImpl = pvz.NewImplementation(
+			srv.AppService(ctx),
+			srv.log.With().Str("module", "api").Logger(),
+		)
 	}
 
 	return srv.pvzImpl
 }
+
+func (srv *serviceProvider) AuthImpl(ctx context.Context) *auth.Implementation {
+	if srv.authImpl == nil {
+		srv.authImpl = auth.NewImplementation(
+			srv.AppService(ctx),
+			srv.log.With().Str("module", "api").Logger(),
+		)
+	}
+
+	return srv.authImpl
+}
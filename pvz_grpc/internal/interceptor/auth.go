@@ -0,0 +1,197 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer"
+
+	reflectionPrefix = "/grpc.reflection."
+)
+
+// methodPermissions lists the permission required by every RPC, matching the
+// rules of the HTTP routes. An empty permission only requires a valid token;
+// methods that are not listed are denied, so a new RPC stays closed until its
+// rule is added here.
+var methodPermissions = map[string]string{
+	"/pvz_v1.PVZService/GetPVZList":         service.PermPVZRead,
+	"/pvz_v1.PVZService/CreatePVZ":          service.PermPVZCreate,
+	"/pvz_v1.PVZService/CreateReception":    service.PermReceptionCreate,
+	"/pvz_v1.PVZService/CloseLastReception": service.PermReceptionClose,
+	"/pvz_v1.PVZService/AddProduct":         service.PermProductCreate,
+	"/pvz_v1.PVZService/DeleteLastProduct":  service.PermProductDelete,
+	"/pvz_v1.PVZService/StreamPVZDetails":   service.PermPVZRead,
+	"/pvz_v1.AuthService/Logout":            "",
+}
+
+// publicMethods are served without a token, like the matching HTTP routes.
+var publicMethods = map[string]struct{}{
+	"/pvz_v1.AuthService/Register":        {},
+	"/pvz_v1.AuthService/Login":           {},
+	"/pvz_v1.AuthService/LoginTwoFactor":  {},
+	"/pvz_v1.AuthService/EnrollTwoFactor": {},
+	"/pvz_v1.AuthService/DummyLogin":      {},
+	"/pvz_v1.AuthService/RefreshToken":    {},
+}
+
+type claimsKey struct{}
+
+type cityScopeKey struct{}
+
+type AuthInterceptor struct {
+	tokenMaker *token.JWTMaker
+	access     service.Access
+	log        zerolog.Logger
+}
+
+func NewAuthInterceptor(tokenMaker *token.JWTMaker, access service.Access, log zerolog.Logger) *AuthInterceptor {
+	return &AuthInterceptor{
+		tokenMaker: tokenMaker,
+		access:     access,
+		log:        log,
+	}
+}
+
+func (itc *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, err := itc.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (itc *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := itc.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func (itc *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	if strings.HasPrefix(method, reflectionPrefix) {
+		return ctx, nil
+	}
+
+	if _, ok := publicMethods[method]; ok {
+		return ctx, nil
+	}
+
+	claims, err := itc.verifyClaimsFromMetadata(ctx)
+	if err != nil {
+		itc.log.Warn().Err(err).Str("method", method).Msg("unauthenticated call")
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if claims.Dummy {
+		itc.log.Info().Str("method", method).Str("user_id", claims.ID.String()).
+			Str("role", claims.Role).Bool("dummy", true).Msg("call with dummy token")
+	}
+
+	permission, ok := methodPermissions[method]
+	if !ok {
+		itc.log.Warn().Str("method", method).Msg("method has no permission rule")
+		return nil, status.Error(codes.PermissionDenied, service.ErrPermissionDenied.Error())
+	}
+
+	if permission != "" {
+		allowed, err := itc.access.HasPermission(ctx, claims.Role, permission)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if !allowed {
+			itc.log.Warn().Str("method", method).Str("role", claims.Role).Msg("permission denied")
+			return nil, status.Error(codes.PermissionDenied, service.ErrPermissionDenied.Error())
+		}
+	}
+
+	city, err := itc.access.CityScope(ctx, claims.ID, claims.Role)
+	if errors.Is(err, service.ErrPermissionDenied) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx = context.WithValue(ctx, claimsKey{}, claims)
+	if city != "" {
+		ctx = context.WithValue(ctx, cityScopeKey{}, city)
+	}
+
+	return ctx, nil
+}
+
+func (itc *AuthInterceptor) verifyClaimsFromMetadata(ctx context.Context) (*token.UserClaims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("Неавторизован")
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return nil, errors.New("Неавторизован")
+	}
+
+	fields := strings.Fields(values[0])
+	if len(fields) != 2 || !strings.EqualFold(fields[0], bearerPrefix) {
+		return nil, errors.New("invalid autorization header")
+	}
+
+	claims, err := itc.tokenMaker.VerifyToken(ctx, fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	return claims, nil
+}
+
+// ClaimsFromContext returns the claims of the caller authenticated by AuthInterceptor.
+func ClaimsFromContext(ctx context.Context) (*token.UserClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*token.UserClaims)
+	return claims, ok
+}
+
+// CityScopeFromContext returns the city the caller is limited to, or an empty
+// string when the role of the caller is not city scoped.
+func CityScopeFromContext(ctx context.Context) string {
+	city, _ := ctx.Value(cityScopeKey{}).(string)
+	return city
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authServerStream) Context() context.Context {
+	return stream.ctx
+}
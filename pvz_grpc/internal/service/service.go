@@ -1,11 +0,0 @@
-package service
-
-import (
-	"context"
-
-	"github.com/MaksimovDenis/pvz_grpc/internal/models"
-)
-
-type PVZService interface {
-	GetPVZ(ctx context.Context) ([]models.PVZ, error)
-}
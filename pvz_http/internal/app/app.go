@@ -7,8 +7,8 @@ import (
 	"os/signal"
 	"syscall"
 
-	"github.com/MaksimovDenis/avito_pvz/internal/closer"
-	"github.com/MaksimovDenis/avito_pvz/internal/config"
+	"github.com/MaksimovDenis/pvz_core/closer"
+	"github.com/MaksimovDenis/pvz_core/config"
 	"github.com/rs/zerolog/log"
 )
 
@@ -41,6 +41,7 @@ func (app *App) initDeps(ctx context.Context) error {
 	inits := []func(ctx context.Context) error{
 		app.initConfig,
 		app.initServiceProvider,
+		app.initDummyUsers,
 		app.initHTTPServer,
 	}
 
@@ -68,9 +69,23 @@ func (app *App) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+// initDummyUsers removes the seeded dummy users outside development, as their
+// passwords are public.
+func (app *App) initDummyUsers(ctx context.Context) error {
+	if app.serviceProvider.AppConfig().DummyLoginEnabled() {
+		return nil
+	}
+
+	return app.serviceProvider.AppService(ctx).DisableDummyUsers(ctx)
+}
+
 func (app *App) initHTTPServer(ctx context.Context) error {
 	router := app.serviceProvider.AppHandler(ctx).InitRoutes()
 
+	if err := router.SetTrustedProxies(app.serviceProvider.ServerConfig().TrustedProxies()); err != nil {
+		return err
+	}
+
 	app.httpServer = &http.Server{
 		Addr:    app.serviceProvider.ServerConfig().Address(),
 		Handler: router,
@@ -4,24 +4,32 @@ import (
 	"context"
 	"os"
 
-	db "github.com/MaksimovDenis/avito_pvz/internal/client"
-	"github.com/MaksimovDenis/avito_pvz/internal/client/db/pg"
-	"github.com/MaksimovDenis/avito_pvz/internal/client/db/transaction"
-	"github.com/MaksimovDenis/avito_pvz/internal/closer"
-	"github.com/MaksimovDenis/avito_pvz/internal/config"
 	"github.com/MaksimovDenis/avito_pvz/internal/handler"
 	"github.com/MaksimovDenis/avito_pvz/internal/metrics"
-	"github.com/MaksimovDenis/avito_pvz/internal/repository"
-	"github.com/MaksimovDenis/avito_pvz/internal/service"
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	db "github.com/MaksimovDenis/pvz_core/client"
+	"github.com/MaksimovDenis/pvz_core/client/db/pg"
+	"github.com/MaksimovDenis/pvz_core/client/db/transaction"
+	"github.com/MaksimovDenis/pvz_core/closer"
+	"github.com/MaksimovDenis/pvz_core/config"
+	coreMetrics "github.com/MaksimovDenis/pvz_core/metrics"
+	"github.com/MaksimovDenis/pvz_core/pkg/mailer"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/repository"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
 type serviceProvider struct {
-	pgConfig     config.PGConfig
-	serverConfig config.ServerConfig
-	tokenConfig  config.TokenConfig
+	pgConfig           config.PGConfig
+	serverConfig       config.ServerConfig
+	tokenConfig        config.TokenConfig
+	scheduleConfig     config.ScheduleConfig
+	passwordConfig     config.PasswordConfig
+	mailerConfig       config.MailerConfig
+	appConfig          config.AppConfig
+	twoFactorConfig    config.TwoFactorConfig
+	registrationConfig config.RegistrationConfig
 
 	dbClient      db.Client
 	txManager     db.TxManager
@@ -33,8 +41,11 @@ type serviceProvider struct {
 
 	tokenMaker *token.JWTMaker
 
-	log     zerolog.Logger
-	metrics *metrics.Metrics
+	mailer mailer.Sender
+
+	log         zerolog.Logger
+	metrics     *metrics.Metrics
+	coreMetrics *coreMetrics.Metrics
 }
 
 func newServiceProvider() *serviceProvider {
@@ -75,11 +86,22 @@ func (srv *serviceProvider) PGConfig() config.PGConfig {
 	return srv.pgConfig
 }
 
-func (srv *serviceProvider) initMetric() *metrics.Metrics {
-	srv.metrics = metrics.New()
+func (srv *serviceProvider) Metrics() *metrics.Metrics {
+	if srv.metrics == nil {
+		srv.metrics = metrics.New()
+	}
+
 	return srv.metrics
 }
 
+func (srv *serviceProvider) CoreMetrics() *coreMetrics.Metrics {
+	if srv.coreMetrics == nil {
+		srv.coreMetrics = coreMetrics.New()
+	}
+
+	return srv.coreMetrics
+}
+
 func (srv *serviceProvider) ServerConfig() config.ServerConfig {
 	if srv.serverConfig == nil {
 		cfg, err := config.NewServerConfig()
@@ -95,9 +117,9 @@ func (srv *serviceProvider) ServerConfig() config.ServerConfig {
 
 func (srv *serviceProvider) TokenConfig() config.TokenConfig {
 	if srv.tokenConfig == nil {
-		cfg, err := config.NewSecretKey()
+		cfg, err := config.NewTokenConfig()
 		if err != nil {
-			log.Fatal().Err(err).Msg("failed dto get secret key config")
+			log.Fatal().Err(err).Msg("failed to get token config")
 		}
 
 		srv.tokenConfig = cfg
@@ -106,6 +128,96 @@ func (srv *serviceProvider) TokenConfig() config.TokenConfig {
 	return srv.tokenConfig
 }
 
+func (srv *serviceProvider) ScheduleConfig() config.ScheduleConfig {
+	if srv.scheduleConfig == nil {
+		cfg, err := config.NewScheduleConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get schedule config")
+		}
+
+		srv.scheduleConfig = cfg
+	}
+
+	return srv.scheduleConfig
+}
+
+func (srv *serviceProvider) PasswordConfig() config.PasswordConfig {
+	if srv.passwordConfig == nil {
+		cfg, err := config.NewPasswordConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get password config")
+		}
+
+		srv.passwordConfig = cfg
+	}
+
+	return srv.passwordConfig
+}
+
+func (srv *serviceProvider) MailerConfig() config.MailerConfig {
+	if srv.mailerConfig == nil {
+		cfg, err := config.NewMailerConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get mailer config")
+		}
+
+		srv.mailerConfig = cfg
+	}
+
+	return srv.mailerConfig
+}
+
+func (srv *serviceProvider) AppConfig() config.AppConfig {
+	if srv.appConfig == nil {
+		cfg, err := config.NewAppConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get app config")
+		}
+
+		srv.appConfig = cfg
+	}
+
+	return srv.appConfig
+}
+
+func (srv *serviceProvider) TwoFactorConfig() config.TwoFactorConfig {
+	if srv.twoFactorConfig == nil {
+		cfg, err := config.NewTwoFactorConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get two-factor config")
+		}
+
+		srv.twoFactorConfig = cfg
+	}
+
+	return srv.twoFactorConfig
+}
+
+func (srv *serviceProvider) RegistrationConfig() config.RegistrationConfig {
+	if srv.registrationConfig == nil {
+		cfg, err := config.NewRegistrationConfig()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get registration config")
+		}
+
+		srv.registrationConfig = cfg
+	}
+
+	return srv.registrationConfig
+}
+
+func (srv *serviceProvider) Mailer() mailer.Sender {
+	if srv.mailer == nil {
+		if srv.MailerConfig().File() != "" {
+			srv.mailer = mailer.NewFileSender(srv.MailerConfig().File())
+		} else {
+			srv.mailer = mailer.NewLogSender(srv.log.With().Str("module", "mailer").Logger())
+		}
+	}
+
+	return srv.mailer
+}
+
 func (srv *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if srv.dbClient == nil {
 		client, err := pg.New(ctx, srv.PGConfig().DSN())
@@ -139,9 +251,31 @@ func (srv *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 
 func (srv *serviceProvider) TokenMaker(ctx context.Context) *token.JWTMaker {
 	if srv.tokenMaker == nil {
-		srv.tokenMaker = token.NewJWTMaker(
-			srv.TokenConfig().SecretKey(),
-		)
+		if srv.TokenConfig().KeysDir() != "" {
+			keys, err := token.LoadKeySet(
+				srv.TokenConfig().KeysDir(),
+				srv.TokenConfig().ActiveKid(),
+				srv.TokenConfig().RetiredKids(),
+			)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to load token signing keys")
+			}
+
+			srv.tokenMaker = token.NewJWTMakerWithKeys(keys)
+		} else if srv.TokenConfig().JWKSURL() != "" {
+			log.Fatal().Msg("HTTP service issues tokens, set TOKEN_KEYS_DIR or TOKEN_SECRET_KEY instead of TOKEN_JWKS_URL")
+		} else {
+			srv.tokenMaker = token.NewJWTMaker(
+				srv.TokenConfig().SecretKey(),
+			)
+		}
+
+		srv.tokenMaker.SetRevocationList(srv.AppRepository(ctx).Tokens)
+		srv.tokenMaker.SetUserStatus(srv.AppRepository(ctx).Authorization)
+
+		if !srv.AppConfig().DummyLoginEnabled() {
+			srv.tokenMaker.DisableDummyTokens()
+		}
 	}
 
 	return srv.tokenMaker
@@ -166,7 +300,13 @@ func (srv *serviceProvider) AppService(ctx context.Context) *service.Service {
 			*srv.TokenMaker(ctx),
 			srv.log.With().Str("module", "service").Logger(),
 			srv.TxManager(ctx),
-			srv.initMetric(),
+			srv.CoreMetrics(),
+			srv.ScheduleConfig(),
+			srv.PasswordConfig(),
+			srv.AppConfig(),
+			srv.TwoFactorConfig(),
+			srv.RegistrationConfig(),
+			srv.Mailer(),
 		)
 	}
 
@@ -179,7 +319,8 @@ func (srv *serviceProvider) AppHandler(ctx context.Context) *handler.Handler {
 			*srv.AppService(ctx),
 			*srv.TokenMaker(ctx),
 			srv.log.With().Str("module", "api").Logger(),
-			srv.metrics,
+			srv.Metrics(),
+			srv.AppConfig().DummyLoginEnabled(),
 		)
 	}
 
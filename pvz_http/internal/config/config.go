@@ -1,12 +0,0 @@
-package config
-
-import "github.com/joho/godotenv"
-
-func Load(path string) error {
-	err := godotenv.Load(path)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
@@ -1,33 +0,0 @@
-package config
-
-import (
-	"errors"
-	"os"
-)
-
-const (
-	dsnenvName = "PG_DSN"
-)
-
-type PGConfig interface {
-	DSN() string
-}
-
-type pgConfig struct {
-	dsn string
-}
-
-func NewPGConfig() (PGConfig, error) {
-	dsn := os.Getenv(dsnenvName)
-	if len(dsn) == 0 {
-		return nil, errors.New("pg dsn not found")
-	}
-
-	return &pgConfig{
-		dsn: dsn,
-	}, nil
-}
-
-func (cfg *pgConfig) DSN() string {
-	return cfg.dsn
-}
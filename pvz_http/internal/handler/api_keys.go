@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func (hdl *Handler) GetApiKeys(ctx *gin.Context) {
+	keys, err := hdl.appService.APIKeys.GetAPIKeys(ctx)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get api keys")
+		ctx.JSON(apiKeyErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	res := make([]oapi.APIKey, len(keys))
+	for idx, key := range keys {
+		res[idx] = converterModelToAPIKey(key)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) PostApiKeys(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	var req oapi.PostApiKeysJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	res, err := hdl.appService.APIKeys.CreateAPIKey(ctx, models.CreateAPIKeyReq{
+		Name:        req.Name,
+		Permissions: req.Permissions,
+		PvzId:       req.PvzId,
+		ExpiresAt:   req.ExpiresAt,
+		CreatedBy:   claims.(*token.UserClaims).ID,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to create api key")
+		ctx.JSON(apiKeyErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, oapi.CreatedAPIKey{
+		ApiKey: converterModelToAPIKey(res.APIKey),
+		Key:    res.Key,
+	})
+}
+
+func (hdl *Handler) DeleteApiKeysKeyId(ctx *gin.Context, keyId types.UUID) {
+	if err := hdl.appService.APIKeys.RevokeAPIKey(ctx, keyId); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to revoke api key")
+		ctx.JSON(apiKeyErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func converterModelToAPIKey(key models.APIKey) oapi.APIKey {
+	return oapi.APIKey{
+		Id:          key.Id,
+		Name:        key.Name,
+		Prefix:      key.Prefix,
+		Permissions: key.Permissions,
+		PvzId:       key.PvzId,
+		ExpiresAt:   key.ExpiresAt,
+		LastUsedAt:  key.LastUsedAt,
+		CreatedBy:   key.CreatedBy,
+		CreatedAt:   key.CreatedAt,
+		RevokedAt:   key.RevokedAt,
+	}
+}
+
+func apiKeyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrAPIKeyNameRequired),
+		errors.Is(err, service.ErrAPIKeyNameTooLong),
+		errors.Is(err, service.ErrAPIKeyPermissionsRequired),
+		errors.Is(err, service.ErrAPIKeyPermissionNotAllowed),
+		errors.Is(err, service.ErrAPIKeyPermissionNotForPVZ),
+		errors.Is(err, service.ErrAPIKeyExpiresInPast):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrPVZNotFound),
+		errors.Is(err, service.ErrAPIKeyNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"errors"
+	"io"
 	"net/http"
 
-	"github.com/MaksimovDenis/avito_pvz/internal/models"
 	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime/types"
 )
@@ -20,6 +24,11 @@ func (hdl *Handler) PostDummyLogin(ctx *gin.Context) {
 	}
 
 	token, err := hdl.appService.DummyLogin(ctx, string(dummyLogin.Role))
+	if errors.Is(err, service.ErrDummyLoginDisabled) {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to auth user")
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -43,21 +52,32 @@ func (hdl *Handler) PostRegister(ctx *gin.Context) {
 	modelReq := models.CreateUserReq{
 		Email:    string(retigterReq.Email),
 		Password: retigterReq.Password,
-		Role:     string(retigterReq.Role),
 	}
 
-	user, err := hdl.appService.Authorization.CreateUser(ctx, modelReq)
+	if retigterReq.Role != nil {
+		modelReq.Role = string(*retigterReq.Role)
+	}
+
+	if retigterReq.InviteToken != nil {
+		modelReq.InviteToken = *retigterReq.InviteToken
+	}
+
+	user, err := hdl.appService.Registration.Register(ctx, modelReq)
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to create user")
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(authErrorStatus(err), gin.H{"error": err.Error()})
 
 		return
 	}
 
+	emailVerified := false
+
 	res := &oapi.User{
-		Id:    &user.Id,
-		Email: types.Email(user.Email),
-		Role:  oapi.UserRole(user.Role),
+		Id:            &user.Id,
+		Email:         types.Email(user.Email),
+		Role:          oapi.UserRole(user.Role),
+		City:          user.City,
+		EmailVerified: &emailVerified,
 	}
 
 	ctx.JSON(http.StatusOK, res)
@@ -76,9 +96,25 @@ func (hdl *Handler) PostLogin(ctx *gin.Context) {
 	modelsReq := models.LoginUserReq{
 		Email:    string(loginReq.Email),
 		Password: string(loginReq.Password),
+		IP:       ctx.ClientIP(),
+	}
+
+	res, err := hdl.appService.LoginUser(ctx, modelsReq)
+	if errors.Is(err, service.ErrInvalidCredentials) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, service.ErrTooManyLoginAttempts) {
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, service.ErrUserDisabled) || errors.Is(err, service.ErrEmailNotVerified) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
 	}
 
-	token, err := hdl.appService.LoginUser(ctx, modelsReq)
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to auth user")
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -86,5 +122,124 @@ func (hdl *Handler) PostLogin(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, token)
+	if res.Challenge != nil {
+		ctx.JSON(http.StatusAccepted, oapi.TwoFactorChallenge{
+			ChallengeToken:     res.Challenge.ChallengeToken,
+			ExpiresAt:          res.Challenge.ExpiresAt,
+			EnrollmentRequired: res.Challenge.EnrollmentRequired,
+		})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, oapi.TokenPair{
+		AccessToken:  res.Tokens.AccessToken,
+		RefreshToken: res.Tokens.RefreshToken,
+	})
+}
+
+func (hdl *Handler) PostTokenRefresh(ctx *gin.Context) {
+	var refreshReq oapi.PostTokenRefreshJSONBody
+
+	if err := ctx.BindJSON(&refreshReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	tokens, err := hdl.appService.RefreshToken(ctx, refreshReq.RefreshToken)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to refresh token")
+
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		if errors.Is(err, service.ErrUserDisabled) || errors.Is(err, service.ErrTwoFactorRequired) {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, oapi.TokenPair{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	})
+}
+
+func (hdl *Handler) PostLogout(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	var logoutReq oapi.PostLogoutJSONBody
+
+	if err := ctx.ShouldBindJSON(&logoutReq); err != nil && !errors.Is(err, io.EOF) {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	userClaims := claims.(*token.UserClaims)
+
+	modelReq := models.LogoutReq{
+		UserId:         userClaims.ID,
+		TokenId:        userClaims.RegisteredClaims.ID,
+		TokenExpiresAt: userClaims.ExpiresAt.Time,
+	}
+
+	if logoutReq.RefreshToken != nil {
+		modelReq.RefreshToken = *logoutReq.RefreshToken
+	}
+
+	if err := hdl.appService.Logout(ctx, modelReq); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to logout user")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func authErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrEmailRequired),
+		errors.Is(err, service.ErrPasswordRequired),
+		errors.Is(err, service.ErrEmailEqualsPassword),
+		errors.Is(err, service.ErrInvalidRole),
+		errors.Is(err, service.ErrCityRequired),
+		errors.Is(err, service.ErrCityNotSupported),
+		errors.Is(err, service.ErrPasswordTooShort),
+		errors.Is(err, service.ErrPasswordTooLong),
+		errors.Is(err, service.ErrPasswordTooWeak),
+		errors.Is(err, service.ErrPasswordBreached),
+		errors.Is(err, service.ErrPasswordUnchanged),
+		errors.Is(err, service.ErrInvalidCurrentPassword),
+		errors.Is(err, service.ErrInvalidResetToken),
+		errors.Is(err, service.ErrInvalidInvite),
+		errors.Is(err, service.ErrInviteEmailMismatch),
+		errors.Is(err, service.ErrInvalidVerificationToken):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrUserDisabled),
+		errors.Is(err, service.ErrInviteRequired):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrAccountNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrTooManyMailRequests):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
 }
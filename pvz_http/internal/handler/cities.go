@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func (hdl *Handler) GetCities(ctx *gin.Context) {
+	cities, err := hdl.appService.City.GetCities(ctx)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get cities")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	res := make([]oapi.City, len(cities))
+	for idx, city := range cities {
+		res[idx] = converterModelToCity(city)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) PostCities(ctx *gin.Context) {
+	var req oapi.CityCreate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	city, err := hdl.appService.City.CreateCity(ctx, models.CreateCityReq{
+		Name:       req.Name,
+		IsActive:   req.IsActive,
+		LaunchDate: req.LaunchDate,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to create city")
+		ctx.JSON(cityErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, converterModelToCity(city))
+}
+
+func (hdl *Handler) PatchCitiesCityId(ctx *gin.Context, cityId types.UUID) {
+	var req oapi.CityUpdate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	city, err := hdl.appService.City.UpdateCity(ctx, cityId, models.UpdateCityReq{
+		IsActive:   req.IsActive,
+		LaunchDate: req.LaunchDate,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to update city")
+		ctx.JSON(cityErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToCity(city))
+}
+
+func (hdl *Handler) DeleteCitiesCityId(ctx *gin.Context, cityId types.UUID) {
+	if err := hdl.appService.City.DeleteCity(ctx, cityId); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to delete city")
+		ctx.JSON(cityErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func cityErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCityNameRequired), errors.Is(err, service.ErrCityNoChanges):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrCityNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrCityAlreadyExists), errors.Is(err, service.ErrCityInUse):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func converterModelToCity(city models.City) oapi.City {
+	return oapi.City{
+		Id:         city.Id,
+		Name:       city.Name,
+		IsActive:   city.IsActive,
+		LaunchDate: city.LaunchDate,
+		CreatedAt:  city.CreatedAt,
+	}
+}
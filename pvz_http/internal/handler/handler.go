@@ -4,9 +4,9 @@ import (
 	"time"
 
 	"github.com/MaksimovDenis/avito_pvz/internal/metrics"
-	"github.com/MaksimovDenis/avito_pvz/internal/service"
 	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
@@ -22,18 +22,21 @@ type Handler struct {
 	tokenMaker *token.JWTMaker
 	log        zerolog.Logger
 	metrics    *metrics.Metrics
+	dummyLogin bool
 }
 
 func NewHandler(
 	appService service.Service,
 	tokenMaker token.JWTMaker,
 	log zerolog.Logger,
-	metrics *metrics.Metrics) *Handler {
+	metrics *metrics.Metrics,
+	dummyLogin bool) *Handler {
 	return &Handler{
 		appService: appService,
 		tokenMaker: &tokenMaker,
 		log:        log,
 		metrics:    metrics,
+		dummyLogin: dummyLogin,
 	}
 }
 
@@ -43,11 +46,13 @@ func (hdl *Handler) InitRoutes() *gin.Engine {
 	router.MaxMultipartMemory = FileUploadBufferSize
 
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	router.GET("/.well-known/jwks.json", hdl.GetJWKS)
 	router.Use(hdl.metrics.HTTPMetrics())
 	oapi.RegisterHandlersWithOptions(router, hdl, oapi.GinServerOptions{
 		BaseURL: "/",
 		Middlewares: []oapi.MiddlewareFunc{
-			GetMiddlewareFunc(hdl.tokenMaker),
+			GetMiddlewareFunc(hdl.tokenMaker, hdl.appService.APIKeys, hdl.dummyLogin, hdl.log),
+			GetPermissionMiddlewareFunc(hdl.appService.Access, hdl.log),
 		},
 	})
 
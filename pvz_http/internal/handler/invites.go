@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func (hdl *Handler) GetInvites(ctx *gin.Context) {
+	invites, err := hdl.appService.Registration.GetInvites(ctx)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get invites")
+		ctx.JSON(inviteErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	res := make([]oapi.Invite, len(invites))
+	for idx, invite := range invites {
+		res[idx] = converterModelToInvite(invite)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) PostInvites(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	var req oapi.PostInvitesJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	modelReq := models.CreateInviteReq{
+		Role:      string(req.Role),
+		City:      req.City,
+		CreatedBy: claims.(*token.UserClaims).ID,
+	}
+
+	if req.Email != nil {
+		email := string(*req.Email)
+		modelReq.Email = &email
+	}
+
+	res, err := hdl.appService.Registration.CreateInvite(ctx, modelReq)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to create invite")
+		ctx.JSON(inviteErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, oapi.CreatedInvite{
+		Invite: converterModelToInvite(res.Invite),
+		Token:  res.Token,
+	})
+}
+
+func (hdl *Handler) DeleteInvitesInviteId(ctx *gin.Context, inviteId types.UUID) {
+	if err := hdl.appService.Registration.RevokeInvite(ctx, inviteId); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to revoke invite")
+		ctx.JSON(inviteErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (hdl *Handler) PostEmailVerify(ctx *gin.Context) {
+	var req oapi.PostEmailVerifyJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	if err := hdl.appService.Registration.VerifyEmail(ctx, req.Token); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to verify email")
+		ctx.JSON(authErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (hdl *Handler) PostEmailVerifyResend(ctx *gin.Context) {
+	var req oapi.PostEmailVerifyResendJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	err := hdl.appService.Registration.ResendEmailVerification(ctx, models.ResendEmailVerificationReq{
+		Email: string(req.Email),
+		IP:    ctx.ClientIP(),
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to resend email verification")
+		ctx.JSON(authErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
+
+func converterModelToInvite(invite models.Invite) oapi.Invite {
+	res := oapi.Invite{
+		Id:        invite.Id,
+		Role:      invite.Role,
+		City:      invite.City,
+		CreatedBy: invite.CreatedBy,
+		ExpiresAt: invite.ExpiresAt,
+		UsedAt:    invite.UsedAt,
+		UsedBy:    invite.UsedBy,
+		RevokedAt: invite.RevokedAt,
+		CreatedAt: invite.CreatedAt,
+	}
+
+	if invite.Email != nil {
+		email := types.Email(*invite.Email)
+		res.Email = &email
+	}
+
+	return res
+}
+
+func inviteErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidRole),
+		errors.Is(err, service.ErrCityRequired),
+		errors.Is(err, service.ErrCityNotSupported):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrInviteNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,29 +1,81 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
 )
 
-func GetMiddlewareFunc(tokenMaker *token.JWTMaker) func(ctx *gin.Context) {
+// publicPaths are served without a token.
+var publicPaths = map[string]bool{
+	"/register":               true,
+	"/login":                  true,
+	"/login/2fa":              true,
+	"/login/2fa/enroll":       true,
+	"/token/refresh":          true,
+	"/password/reset":         true,
+	"/password/reset/confirm": true,
+	"/email/verify":           true,
+	"/email/verify/resend":    true,
+}
+
+const (
+	apiKeyHeader     = "X-API-Key"
+	apiKeyContextKey = "apiKey"
+)
+
+// GetMiddlewareFunc authenticates the caller by the bearer token or, without
+// an Authorization header, by the X-API-Key header. An API key acts as its
+// service user and is stored under apiKeyContextKey for the permission check.
+// /dummyLogin is public only while dummy login is enabled, otherwise it needs
+// a token like any other route and is then denied by the permission middleware.
+func GetMiddlewareFunc(tokenMaker *token.JWTMaker, apiKeys service.APIKeys, dummyLogin bool,
+	log zerolog.Logger) func(ctx *gin.Context) {
 	return func(ctx *gin.Context) {
-		if ctx.Request.URL.Path == "/dummyLogin" ||
-			ctx.Request.URL.Path == "/register" ||
-			ctx.Request.URL.Path == "/login" {
+		path := ctx.Request.URL.Path
+		if publicPaths[path] || (dummyLogin && path == "/dummyLogin") {
 			ctx.Next()
 			return
 		}
 
+		if key := ctx.GetHeader(apiKeyHeader); key != "" && ctx.GetHeader("Authorization") == "" {
+			apiKey, err := apiKeys.AuthenticateAPIKey(ctx, key)
+			if errors.Is(err, service.ErrInvalidAPIKey) {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			} else if err != nil {
+				ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			log.Info().Str("method", ctx.Request.Method).Str("path", path).
+				Str("api_key_id", apiKey.Id.String()).Msg("request with api key")
+
+			ctx.Set(apiKeyContextKey, apiKey)
+			ctx.Set("user", &token.UserClaims{ID: apiKey.UserId, Role: service.APIKeyRole})
+			ctx.Next()
+
+			return
+		}
+
 		claims, err := verifyClaimsFromAuthHeader(ctx, *tokenMaker)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 			return
 		}
 
+		if claims.Dummy {
+			log.Info().Str("method", ctx.Request.Method).Str("path", path).
+				Str("user_id", claims.ID.String()).Str("role", claims.Role).Bool("dummy", true).
+				Msg("request with dummy token")
+		}
+
 		ctx.Set("user", claims)
 		ctx.Next()
 	}
@@ -43,7 +95,7 @@ func verifyClaimsFromAuthHeader(ctx *gin.Context, tokenMaker token.JWTMaker) (*t
 
 	token := fields[1]
 
-	claims, err := tokenMaker.VerifyToken(token)
+	claims, err := tokenMaker.VerifyToken(ctx, token)
 	if err != nil {
 		return nil, fmt.Errorf("invalid token: %w", err)
 	}
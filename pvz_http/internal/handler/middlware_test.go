@@ -1,13 +1,18 @@
 package handler
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,7 +60,7 @@ func TestMiddlewareFunc(t *testing.T) {
 
 			testCtx.Request.Header.Set("Authorization", tt.authHeader)
 
-			middleware := GetMiddlewareFunc(tokenMaker)
+			middleware := GetMiddlewareFunc(tokenMaker, nil, true, zerolog.Nop())
 			middleware(testCtx)
 			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
 
@@ -73,6 +78,102 @@ func TestMiddlewareFunc(t *testing.T) {
 	}
 }
 
+func TestMiddlewareFuncDummyLogin(t *testing.T) {
+	tokenMaker := token.NewJWTMaker("supersecretkey")
+
+	tests := []struct {
+		name           string
+		dummyLogin     bool
+		expectedStatus int
+	}{
+		{"Dummy login enabled", true, http.StatusOK},
+		{"Dummy login disabled", false, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			router := gin.New()
+			router.Use(GetMiddlewareFunc(tokenMaker, nil, tt.dummyLogin, zerolog.Nop()))
+			router.POST("/dummyLogin", func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			responseRecord := httptest.NewRecorder()
+			router.ServeHTTP(responseRecord, httptest.NewRequest(http.MethodPost, "/dummyLogin", nil))
+
+			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
+		})
+	}
+}
+
+type apiKeysStub struct {
+	service.APIKeys
+	keys map[string]models.APIKey
+}
+
+func (stub apiKeysStub) AuthenticateAPIKey(_ context.Context, key string) (models.APIKey, error) {
+	apiKey, ok := stub.keys[key]
+	if !ok {
+		return apiKey, service.ErrInvalidAPIKey
+	}
+
+	return apiKey, nil
+}
+
+func TestMiddlewareFuncAPIKey(t *testing.T) {
+	tokenMaker := token.NewJWTMaker("supersecretkey")
+
+	apiKey := models.APIKey{Id: uuid.New(), UserId: uuid.New(), Permissions: []string{service.PermReceptionCreate}}
+	apiKeys := apiKeysStub{keys: map[string]models.APIKey{"pvz_valid": apiKey}}
+
+	tests := []struct {
+		name           string
+		apiKey         string
+		authHeader     string
+		expectedStatus int
+		expectedUser   uuid.UUID
+	}{
+		{"Valid key", "pvz_valid", "", http.StatusOK, apiKey.UserId},
+		{"Unknown key", "pvz_unknown", "", http.StatusUnauthorized, uuid.Nil},
+		{"Bearer header wins", "pvz_valid", "Token 123", http.StatusUnauthorized, uuid.Nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			var userId uuid.UUID
+
+			router := gin.New()
+			router.Use(GetMiddlewareFunc(tokenMaker, apiKeys, false, zerolog.Nop()))
+			router.POST("/receptions", func(ctx *gin.Context) {
+				claims, _ := ctx.Get("user")
+				userId = claims.(*token.UserClaims).ID
+
+				_, ok := ctx.Get(apiKeyContextKey)
+				assert.True(t, ok)
+
+				ctx.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/receptions", nil)
+			req.Header.Set(apiKeyHeader, tt.apiKey)
+
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			responseRecord := httptest.NewRecorder()
+			router.ServeHTTP(responseRecord, req)
+
+			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
+			assert.Equal(t, tt.expectedUser, userId)
+		})
+	}
+}
+
 func TestVerifyClaimsFromAuthHeader(t *testing.T) {
 	secretKey := "supersecretkey"
 	tokenMaker := token.NewJWTMaker(secretKey)
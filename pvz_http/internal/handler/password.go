@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) PostMePassword(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	var req oapi.PostMePasswordJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	err := hdl.appService.Passwords.ChangePassword(ctx, models.ChangePasswordReq{
+		UserId:          claims.(*token.UserClaims).ID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to change password")
+		ctx.JSON(authErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (hdl *Handler) PostPasswordReset(ctx *gin.Context) {
+	var req oapi.PostPasswordResetJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	err := hdl.appService.Passwords.RequestPasswordReset(ctx, models.PasswordResetReq{
+		Email: string(req.Email),
+		IP:    ctx.ClientIP(),
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to request password reset")
+		ctx.JSON(authErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
+
+func (hdl *Handler) PostPasswordResetConfirm(ctx *gin.Context) {
+	var req oapi.PostPasswordResetConfirmJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	err := hdl.appService.Passwords.ResetPassword(ctx, models.ResetPasswordReq{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to reset password")
+		ctx.JSON(authErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const cityScopeKey = "cityScope"
+
+// routePermissions declares the permission required by every authenticated
+// route. An empty permission only requires a valid token; routes that are not
+// listed are denied, so a new route stays closed until its rule is added here.
+var routePermissions = map[string]string{
+	"POST /logout":      "",
+	"POST /me/password": "",
+
+	"POST /me/2fa/enroll":  "",
+	"POST /me/2fa/confirm": "",
+	"POST /me/2fa/disable": "",
+
+	"GET /users":            service.PermUserManage,
+	"GET /users/:userId":    service.PermUserManage,
+	"PATCH /users/:userId":  service.PermUserManage,
+	"DELETE /users/:userId": service.PermUserManage,
+
+	"GET /cities":                 "",
+	"POST /cities":                service.PermCityManage,
+	"PATCH /cities/:cityId":       service.PermCityManage,
+	"DELETE /cities/:cityId":      service.PermCityManage,
+	"GET /product-types":          "",
+	"POST /product-types":         service.PermProductTypeManage,
+	"PATCH /product-types/:code":  service.PermProductTypeManage,
+	"DELETE /product-types/:code": service.PermProductTypeManage,
+
+	"GET /pvz":                    service.PermPVZRead,
+	"GET /pvz/nearby":             service.PermPVZRead,
+	"POST /pvz":                   service.PermPVZCreate,
+	"PATCH /pvz/:pvzId":           service.PermPVZUpdate,
+	"POST /pvz/:pvzId/deactivate": service.PermPVZUpdate,
+	"DELETE /pvz/:pvzId":          service.PermPVZDelete,
+
+	"GET /pvz/:pvzId/schedule":                     service.PermPVZRead,
+	"PUT /pvz/:pvzId/schedule":                     service.PermScheduleManage,
+	"PUT /pvz/:pvzId/schedule/exceptions/:date":    service.PermScheduleManage,
+	"DELETE /pvz/:pvzId/schedule/exceptions/:date": service.PermScheduleManage,
+
+	"GET /pvz/:pvzId/staff":            service.PermStaffManage,
+	"POST /pvz/:pvzId/staff":           service.PermStaffManage,
+	"DELETE /pvz/:pvzId/staff/:userId": service.PermStaffManage,
+
+	"POST /receptions":                      service.PermReceptionCreate,
+	"GET /receptions/:receptionId":          service.PermPVZRead,
+	"GET /pvz/:pvzId/receptions":            service.PermPVZRead,
+	"POST /pvz/:pvzId/close_last_reception": service.PermReceptionClose,
+	"POST /products":                        service.PermProductCreate,
+	"POST /pvz/:pvzId/delete_last_product":  service.PermProductDelete,
+
+	"GET /api-keys":           service.PermAPIKeyManage,
+	"POST /api-keys":          service.PermAPIKeyManage,
+	"DELETE /api-keys/:keyId": service.PermAPIKeyManage,
+
+	"GET /invites":              service.PermUserManage,
+	"POST /invites":             service.PermUserManage,
+	"DELETE /invites/:inviteId": service.PermUserManage,
+}
+
+// GetPermissionMiddlewareFunc checks the permission of the route for the
+// caller authenticated by GetMiddlewareFunc. For city scoped roles it also
+// rejects PVZ of other cities and stores the city under cityScopeKey.
+func GetPermissionMiddlewareFunc(access service.Access, log zerolog.Logger) func(ctx *gin.Context) {
+	return func(ctx *gin.Context) {
+		value, ok := ctx.Get("user")
+		if !ok {
+			ctx.Next()
+			return
+		}
+
+		claims := value.(*token.UserClaims)
+		route := ctx.Request.Method + " " + ctx.FullPath()
+
+		permission, ok := routePermissions[route]
+		if !ok {
+			log.Warn().Str("route", route).Msg("route has no permission rule")
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": service.ErrPermissionDenied.Error()})
+
+			return
+		}
+
+		if apiKey, ok := ctx.Get(apiKeyContextKey); ok {
+			checkAPIKeyPermission(ctx, apiKey.(models.APIKey), route, permission, log)
+			return
+		}
+
+		if permission != "" {
+			allowed, err := access.HasPermission(ctx, claims.Role, permission)
+			if err != nil {
+				ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			if !allowed {
+				log.Warn().Str("route", route).Str("role", claims.Role).Msg("permission denied")
+				ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": service.ErrPermissionDenied.Error()})
+
+				return
+			}
+		}
+
+		city, err := access.CityScope(ctx, claims.ID, claims.Role)
+		if err != nil {
+			ctx.AbortWithStatusJSON(accessErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		if city == "" {
+			ctx.Next()
+			return
+		}
+
+		if pvzId, err := uuid.Parse(ctx.Param("pvzId")); err == nil {
+			if err := access.CheckPVZScope(ctx, pvzId, city); err != nil {
+				ctx.AbortWithStatusJSON(accessErrorStatus(err), gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		ctx.Set(cityScopeKey, city)
+		ctx.Next()
+	}
+}
+
+// checkAPIKeyPermission lets an API key reach only the routes whose
+// permission is granted to the key. Routes that merely need a token serve the
+// user's own account, so they stay closed to keys.
+func checkAPIKeyPermission(ctx *gin.Context, apiKey models.APIKey, route, permission string, log zerolog.Logger) {
+	if permission == "" || !slices.Contains(apiKey.Permissions, permission) {
+		log.Warn().Str("route", route).Str("api_key_id", apiKey.Id.String()).Msg("api key permission denied")
+		ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": service.ErrPermissionDenied.Error()})
+
+		return
+	}
+
+	if apiKey.PvzId != nil {
+		if pvzId, err := uuid.Parse(ctx.Param("pvzId")); err == nil && pvzId != *apiKey.PvzId {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": service.ErrPVZAccessDenied.Error()})
+			return
+		}
+	}
+
+	ctx.Next()
+}
+
+func accessErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrPermissionDenied), errors.Is(err, service.ErrPVZOutOfScope):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrPVZNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// scopeCity narrows the requested city to the city scope of the caller. It
+// writes 403 and returns false when a city scoped caller asks for another city.
+func scopeCity(ctx *gin.Context, city string) (string, bool) {
+	scope := ctx.GetString(cityScopeKey)
+	if scope == "" {
+		return city, true
+	}
+
+	if city != "" && city != scope {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": service.ErrPVZOutOfScope.Error()})
+		return "", false
+	}
+
+	return scope, true
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+var publicRoutes = map[string]bool{
+	"POST /dummyLogin":    true,
+	"POST /register":      true,
+	"POST /login":         true,
+	"POST /token/refresh": true,
+
+	"POST /password/reset":         true,
+	"POST /password/reset/confirm": true,
+
+	"POST /login/2fa":        true,
+	"POST /login/2fa/enroll": true,
+
+	"POST /email/verify":        true,
+	"POST /email/verify/resend": true,
+}
+
+type accessStub struct {
+	permissions map[string][]string
+	cities      map[string]string
+	pvzCity     map[uuid.UUID]string
+}
+
+func (stub accessStub) HasPermission(ctx context.Context, role, permission string) (bool, error) {
+	for _, value := range stub.permissions[role] {
+		if value == permission {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (stub accessStub) CityScope(ctx context.Context, userId uuid.UUID, role string) (string, error) {
+	return stub.cities[role], nil
+}
+
+func (stub accessStub) CheckPVZScope(ctx context.Context, pvzId uuid.UUID, city string) error {
+	if city == "" {
+		return nil
+	}
+
+	pvzCity, ok := stub.pvzCity[pvzId]
+	if !ok {
+		return service.ErrPVZNotFound
+	}
+
+	if pvzCity != city {
+		return service.ErrPVZOutOfScope
+	}
+
+	return nil
+}
+
+func TestRoutePermissionsCoverAllRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	oapi.RegisterHandlers(router, &Handler{})
+
+	for _, route := range router.Routes() {
+		key := route.Method + " " + route.Path
+		if publicRoutes[key] {
+			continue
+		}
+
+		_, ok := routePermissions[key]
+		assert.True(t, ok, "route %s has no permission rule", key)
+	}
+}
+
+func TestPermissionMiddlewareFunc(t *testing.T) {
+	kazanPVZ, moscowPVZ := uuid.New(), uuid.New()
+
+	access := accessStub{
+		permissions: map[string][]string{
+			"moderator":        {service.PermPVZRead, service.PermPVZCreate, service.PermPVZUpdate},
+			"auditor":          {service.PermPVZRead},
+			"regional_manager": {service.PermPVZRead, service.PermPVZUpdate},
+		},
+		cities:  map[string]string{"regional_manager": "Казань"},
+		pvzCity: map[uuid.UUID]string{kazanPVZ: "Казань", moscowPVZ: "Москва"},
+	}
+
+	tests := []struct {
+		name           string
+		role           string
+		method         string
+		path           string
+		target         string
+		expectedStatus int
+		expectedCity   string
+	}{
+		{"Permission granted", "moderator", http.MethodPost, "/pvz", "/pvz", http.StatusOK, ""},
+		{"Read only role", "auditor", http.MethodPost, "/pvz", "/pvz", http.StatusForbidden, ""},
+		{"Route without rule", "moderator", http.MethodGet, "/unknown", "/unknown", http.StatusForbidden, ""},
+		{"Authenticated only route", "auditor", http.MethodGet, "/cities", "/cities", http.StatusOK, ""},
+		{"City scope is stored", "regional_manager", http.MethodGet, "/pvz", "/pvz", http.StatusOK, "Казань"},
+		{"PVZ of own city", "regional_manager", http.MethodPatch, "/pvz/:pvzId",
+			"/pvz/" + kazanPVZ.String(), http.StatusOK, "Казань"},
+		{"PVZ of another city", "regional_manager", http.MethodPatch, "/pvz/:pvzId",
+			"/pvz/" + moscowPVZ.String(), http.StatusForbidden, ""},
+		{"Unknown PVZ", "regional_manager", http.MethodPatch, "/pvz/:pvzId",
+			"/pvz/" + uuid.NewString(), http.StatusNotFound, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			var city string
+
+			router := gin.New()
+			router.Handle(tt.method, tt.path, func(ctx *gin.Context) {
+				ctx.Set("user", &token.UserClaims{ID: uuid.New(), Role: tt.role})
+
+				GetPermissionMiddlewareFunc(access, zerolog.Nop())(ctx)
+				if ctx.IsAborted() {
+					return
+				}
+
+				city = ctx.GetString(cityScopeKey)
+				ctx.Status(http.StatusOK)
+			})
+
+			responseRecord := httptest.NewRecorder()
+			router.ServeHTTP(responseRecord, httptest.NewRequest(tt.method, tt.target, nil))
+
+			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
+			assert.Equal(t, tt.expectedCity, city)
+		})
+	}
+}
+
+func TestPermissionMiddlewareFuncAPIKey(t *testing.T) {
+	keyPVZ := uuid.New()
+
+	tests := []struct {
+		name           string
+		pvzId          *uuid.UUID
+		method         string
+		path           string
+		target         string
+		expectedStatus int
+	}{
+		{"Granted permission", nil, http.MethodPost, "/receptions", "/receptions", http.StatusOK},
+		{"Permission not granted", nil, http.MethodPost, "/pvz", "/pvz", http.StatusForbidden},
+		{"Authenticated only route", nil, http.MethodPost, "/logout", "/logout", http.StatusForbidden},
+		{"Restricted PVZ", &keyPVZ, http.MethodPost, "/pvz/:pvzId/close_last_reception",
+			"/pvz/" + keyPVZ.String() + "/close_last_reception", http.StatusOK},
+		{"Another PVZ", &keyPVZ, http.MethodPost, "/pvz/:pvzId/close_last_reception",
+			"/pvz/" + uuid.NewString() + "/close_last_reception", http.StatusForbidden},
+		{"Unrestricted key", nil, http.MethodPost, "/pvz/:pvzId/close_last_reception",
+			"/pvz/" + uuid.NewString() + "/close_last_reception", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			apiKey := models.APIKey{
+				Id:          uuid.New(),
+				UserId:      uuid.New(),
+				Permissions: []string{service.PermReceptionCreate, service.PermReceptionClose},
+				PvzId:       tt.pvzId,
+			}
+
+			router := gin.New()
+			router.Handle(tt.method, tt.path, func(ctx *gin.Context) {
+				ctx.Set("user", &token.UserClaims{ID: apiKey.UserId, Role: service.APIKeyRole})
+				ctx.Set(apiKeyContextKey, apiKey)
+
+				GetPermissionMiddlewareFunc(accessStub{}, zerolog.Nop())(ctx)
+				if ctx.IsAborted() {
+					return
+				}
+
+				ctx.Status(http.StatusOK)
+			})
+
+			responseRecord := httptest.NewRecorder()
+			router.ServeHTTP(responseRecord, httptest.NewRequest(tt.method, tt.target, nil))
+
+			assert.Equal(t, tt.expectedStatus, responseRecord.Code)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) GetProductTypes(ctx *gin.Context) {
+	productTypes, err := hdl.appService.ProductType.GetProductTypes(ctx)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get product types")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	res := make([]oapi.ProductType, len(productTypes))
+	for idx, productType := range productTypes {
+		res[idx] = converterModelToProductType(productType)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (hdl *Handler) PostProductTypes(ctx *gin.Context) {
+	var req oapi.ProductTypeCreate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	reqModel := models.CreateProductTypeReq{
+		Code:        req.Code,
+		DisplayName: req.DisplayName,
+		IsActive:    req.IsActive,
+	}
+
+	if req.Fragile != nil {
+		reqModel.Fragile = *req.Fragile
+	}
+
+	if req.Oversized != nil {
+		reqModel.Oversized = *req.Oversized
+	}
+
+	productType, err := hdl.appService.ProductType.CreateProductType(ctx, reqModel)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to create product type")
+		ctx.JSON(productTypeErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, converterModelToProductType(productType))
+}
+
+func (hdl *Handler) PatchProductTypesCode(ctx *gin.Context, code string) {
+	var req oapi.ProductTypeUpdate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	productType, err := hdl.appService.ProductType.UpdateProductType(ctx, code, models.UpdateProductTypeReq{
+		DisplayName: req.DisplayName,
+		Fragile:     req.Fragile,
+		Oversized:   req.Oversized,
+		IsActive:    req.IsActive,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to update product type")
+		ctx.JSON(productTypeErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToProductType(productType))
+}
+
+func (hdl *Handler) DeleteProductTypesCode(ctx *gin.Context, code string) {
+	if err := hdl.appService.ProductType.DeleteProductType(ctx, code); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to delete product type")
+		ctx.JSON(productTypeErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func productTypeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrProductTypeCodeRequired),
+		errors.Is(err, service.ErrProductTypeNameRequired),
+		errors.Is(err, service.ErrProductTypeNoChanges):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrProductTypeNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrProductTypeAlreadyExists), errors.Is(err, service.ErrProductTypeInUse):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func converterModelToProductType(productType models.ProductType) oapi.ProductType {
+	return oapi.ProductType{
+		Code:        productType.Code,
+		DisplayName: productType.DisplayName,
+		Fragile:     productType.Fragile,
+		Oversized:   productType.Oversized,
+		IsActive:    productType.IsActive,
+		CreatedAt:   productType.CreatedAt,
+	}
+}
@@ -1,11 +1,13 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
-	"github.com/MaksimovDenis/avito_pvz/internal/models"
 	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime/types"
 )
@@ -19,13 +21,6 @@ func (hdl *Handler) PostProducts(ctx *gin.Context) {
 		return
 	}
 
-	if !employeeRole(claims.(*token.UserClaims).Role) {
-		hdl.log.Error().Msg("user is not employee")
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "у пользователя нет прав"})
-
-		return
-	}
-
 	var req oapi.PostProductsJSONBody
 
 	if err := ctx.BindJSON(&req); err != nil {
@@ -38,10 +33,20 @@ func (hdl *Handler) PostProducts(ctx *gin.Context) {
 	reqModel := models.CreateProductReq{
 		UserId:      claims.(*token.UserClaims).ID,
 		PvzId:       req.PvzId,
-		ProductType: string(req.Type),
+		ProductType: req.Type,
 	}
 
 	res, err := hdl.appService.Product.AddProduct(ctx, reqModel)
+	if errors.Is(err, service.ErrPVZAccessDenied) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, service.ErrProductTypeNotSupported) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to add a product")
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -53,7 +58,7 @@ func (hdl *Handler) PostProducts(ctx *gin.Context) {
 		DateTime:    &res.DateTime,
 		Id:          &res.Id,
 		ReceptionId: res.ReceptionId,
-		Type:        oapi.ProductType(res.ProductType),
+		Type:        res.ProductType,
 	}
 
 	ctx.JSON(http.StatusCreated, resOapi)
@@ -68,14 +73,12 @@ func (hdl *Handler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, uuid types.U
 		return
 	}
 
-	if !employeeRole(claims.(*token.UserClaims).Role) {
-		hdl.log.Error().Msg("user is not employee")
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "у пользователя нет прав"})
-
+	err := hdl.appService.Product.DeleteProductByPVZId(ctx, claims.(*token.UserClaims).ID, uuid)
+	if errors.Is(err, service.ErrPVZAccessDenied) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		return
 	}
 
-	err := hdl.appService.Product.DeleteProductByPVZId(ctx, uuid)
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to delete product")
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -1,13 +1,22 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
-	"time"
+	"strconv"
 
-	"github.com/MaksimovDenis/avito_pvz/internal/models"
 	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	totalCountHeader = "X-Total-Count"
+	nextPageHeader   = "X-Next-Page"
+	nextCursorHeader = "X-Next-Cursor"
 )
 
 func (hdl *Handler) GetPvz(ctx *gin.Context, params oapi.GetPvzParams) {
@@ -21,30 +30,61 @@ func (hdl *Handler) GetPvz(ctx *gin.Context, params oapi.GetPvzParams) {
 		params.Page = &page
 	}
 
-	if params.StartDate == nil {
-		start := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
-		params.StartDate = &start
+	queryParams := models.GetPVZReq{
+		StartDate: params.StartDate,
+		EndTime:   params.EndDate,
+		Limit:     int(*params.Limit),
+		Page:      int(*params.Page),
 	}
 
-	if params.EndDate == nil {
-		end := time.Now()
-		params.EndDate = &end
+	if params.City != nil {
+		queryParams.City = *params.City
 	}
 
-	queryParams := models.GetPVZReq{
-		StartDate: *params.StartDate,
-		EndTime:   *params.EndDate,
-		Limit:     int(*params.Limit),
-		Page:      int(*params.Page),
+	city, ok := scopeCity(ctx, queryParams.City)
+	if !ok {
+		return
+	}
+
+	queryParams.City = city
+
+	if params.PvzStatus != nil {
+		queryParams.PVZStatus = string(*params.PvzStatus)
+	}
+
+	if params.Status != nil {
+		queryParams.ReceptionStatus = string(*params.Status)
+	}
+
+	if params.ProductType != nil {
+		queryParams.ProductType = *params.ProductType
+	}
+
+	if params.Cursor != nil {
+		queryParams.Cursor = *params.Cursor
 	}
 
 	res, err := hdl.appService.PVZ.GetPVZ(ctx, queryParams)
+	if isGetPvzBadRequest(err) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, res)
+	ctx.Header(totalCountHeader, strconv.Itoa(res.Total))
+	if res.NextPage > 0 {
+		ctx.Header(nextPageHeader, strconv.Itoa(res.NextPage))
+	}
+
+	if res.NextCursor != "" {
+		ctx.Header(nextCursorHeader, res.NextCursor)
+	}
+
+	ctx.JSON(http.StatusOK, res.PVZ)
 }
 
 func (hdl *Handler) PostPvz(ctx *gin.Context) {
@@ -56,13 +96,6 @@ func (hdl *Handler) PostPvz(ctx *gin.Context) {
 		return
 	}
 
-	if !adminRole(claims.(*token.UserClaims).Role) {
-		hdl.log.Error().Msg("user is not moderator")
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "у пользователя нет прав"})
-
-		return
-	}
-
 	var req oapi.PVZ
 
 	if err := ctx.BindJSON(&req); err != nil {
@@ -74,37 +107,162 @@ func (hdl *Handler) PostPvz(ctx *gin.Context) {
 
 	newPVZ := models.PVZReq{
 		User_id:          claims.(*token.UserClaims).ID,
-		City:             string(req.City),
+		City:             req.City,
 		RegistrationDate: req.RegistrationDate,
+		Lat:              req.Lat,
+		Lon:              req.Lon,
+	}
+
+	if req.Address != nil {
+		newPVZ.Address = *req.Address
 	}
 
 	createdPVZ, err := hdl.appService.PVZ.CreatePVZ(ctx, newPVZ)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(pvzErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	res := oapi.PVZ{
-		Id:               createdPVZ.Id,
-		City:             oapi.PVZCity(createdPVZ.City),
-		RegistrationDate: createdPVZ.RegistrationDate,
+	ctx.JSON(http.StatusOK, converterModelToPVZ(createdPVZ))
+}
+
+func (hdl *Handler) GetPvzNearby(ctx *gin.Context, params oapi.GetPvzNearbyParams) {
+	req := models.NearbyPVZReq{
+		Lat: params.Lat,
+		Lon: params.Lon,
+	}
+
+	if params.Radius != nil {
+		req.Radius = *params.Radius
+	}
+
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+
+	city, ok := scopeCity(ctx, "")
+	if !ok {
+		return
+	}
+
+	req.City = city
+
+	list, err := hdl.appService.PVZ.GetNearbyPVZ(ctx, req)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to find nearby pvz")
+		ctx.JSON(pvzErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	res := make([]oapi.NearbyPVZ, len(list))
+	for idx, value := range list {
+		res[idx] = oapi.NearbyPVZ{
+			Pvz:      converterModelToPVZ(value.PVZ),
+			Distance: value.Distance,
+		}
 	}
 
 	ctx.JSON(http.StatusOK, res)
 }
 
-func adminRole(role string) bool {
-	if role == "moderator" {
-		return true
+func (hdl *Handler) PatchPvzPvzId(ctx *gin.Context, pvzId types.UUID) {
+	var req oapi.PVZUpdate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	if req.City != nil {
+		if _, ok := scopeCity(ctx, *req.City); !ok {
+			return
+		}
 	}
 
-	return false
+	reqModel := models.UpdatePVZReq{
+		City:    req.City,
+		Address: req.Address,
+		Lat:     req.Lat,
+		Lon:     req.Lon,
+	}
+
+	if req.Status != nil {
+		pvzStatus := string(*req.Status)
+		reqModel.Status = &pvzStatus
+	}
+
+	updatedPVZ, err := hdl.appService.PVZ.UpdatePVZ(ctx, pvzId, reqModel)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to update pvz")
+		ctx.JSON(pvzErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToPVZ(updatedPVZ))
 }
 
-func employeeRole(role string) bool {
-	if role == "employee" {
-		return true
+func (hdl *Handler) PostPvzPvzIdDeactivate(ctx *gin.Context, pvzId types.UUID) {
+	closedPVZ, err := hdl.appService.PVZ.DeactivatePVZ(ctx, pvzId)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to deactivate pvz")
+		ctx.JSON(pvzErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
 	}
 
-	return false
+	ctx.JSON(http.StatusOK, converterModelToPVZ(closedPVZ))
+}
+
+func (hdl *Handler) DeletePvzPvzId(ctx *gin.Context, pvzId types.UUID) {
+	if err := hdl.appService.PVZ.DeletePVZ(ctx, pvzId); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to delete pvz")
+		ctx.JSON(pvzErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func pvzErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrPVZNoChanges),
+		errors.Is(err, service.ErrInvalidPVZStatus),
+		errors.Is(err, service.ErrCityNotSupported),
+		errors.Is(err, service.ErrInvalidCoordinates),
+		errors.Is(err, service.ErrInvalidRadius):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrPVZNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrPVZHasOpenReception), errors.Is(err, service.ErrPVZHasReceptions):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func converterModelToPVZ(pvz models.PVZRes) oapi.PVZ {
+	pvzStatus := oapi.PVZStatus(pvz.Status)
+
+	return oapi.PVZ{
+		Id:               pvz.Id,
+		City:             pvz.City,
+		RegistrationDate: pvz.RegistrationDate,
+		Status:           &pvzStatus,
+		Address:          &pvz.Address,
+		Lat:              pvz.Lat,
+		Lon:              pvz.Lon,
+		IsOpenNow:        pvz.IsOpenNow,
+	}
+}
+
+func isGetPvzBadRequest(err error) bool {
+	return errors.Is(err, service.ErrInvalidPageToken) ||
+		errors.Is(err, service.ErrInvalidDateRange) ||
+		errors.Is(err, service.ErrInvalidReceptionStatus) ||
+		errors.Is(err, service.ErrInvalidPVZStatus)
 }
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func (hdl *Handler) GetPvzPvzIdSchedule(ctx *gin.Context, pvzId types.UUID) {
+	schedule, err := hdl.appService.PVZSchedule.GetPVZSchedule(ctx, pvzId)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get pvz schedule")
+		ctx.JSON(scheduleErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToSchedule(schedule))
+}
+
+func (hdl *Handler) PutPvzPvzIdSchedule(ctx *gin.Context, pvzId types.UUID) {
+	var req oapi.PVZScheduleUpdate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	days := make([]models.PVZScheduleDay, len(req.Days))
+	for idx, day := range req.Days {
+		days[idx] = models.PVZScheduleDay{
+			Weekday:   day.Weekday,
+			OpenTime:  day.OpenTime,
+			CloseTime: day.CloseTime,
+		}
+	}
+
+	schedule, err := hdl.appService.PVZSchedule.SetPVZSchedule(ctx, pvzId, days)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to set pvz schedule")
+		ctx.JSON(scheduleErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToSchedule(schedule))
+}
+
+func (hdl *Handler) PutPvzPvzIdScheduleExceptionsDate(ctx *gin.Context, pvzId types.UUID, date types.Date) {
+	var req oapi.ScheduleExceptionUpdate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	exception, err := hdl.appService.PVZSchedule.SetPVZScheduleException(ctx, pvzId, models.PVZScheduleException{
+		Date:      date.Time,
+		IsClosed:  req.IsClosed,
+		OpenTime:  req.OpenTime,
+		CloseTime: req.CloseTime,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to set pvz schedule exception")
+		ctx.JSON(scheduleErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToScheduleException(exception))
+}
+
+func (hdl *Handler) DeletePvzPvzIdScheduleExceptionsDate(ctx *gin.Context, pvzId types.UUID, date types.Date) {
+	if err := hdl.appService.PVZSchedule.DeletePVZScheduleException(ctx, pvzId, date.Time); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to delete pvz schedule exception")
+		ctx.JSON(scheduleErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func scheduleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidWeekday),
+		errors.Is(err, service.ErrDuplicateWeekday),
+		errors.Is(err, service.ErrInvalidWorkingHours):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrPVZNotFound), errors.Is(err, service.ErrScheduleExceptionNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func converterModelToSchedule(schedule models.PVZSchedule) oapi.PVZSchedule {
+	res := oapi.PVZSchedule{
+		PvzId:      schedule.PVZId,
+		Days:       make([]oapi.ScheduleDay, len(schedule.Days)),
+		Exceptions: make([]oapi.ScheduleException, len(schedule.Exceptions)),
+	}
+
+	for idx, day := range schedule.Days {
+		res.Days[idx] = oapi.ScheduleDay{
+			Weekday:   day.Weekday,
+			OpenTime:  day.OpenTime,
+			CloseTime: day.CloseTime,
+		}
+	}
+
+	for idx, exception := range schedule.Exceptions {
+		res.Exceptions[idx] = converterModelToScheduleException(exception)
+	}
+
+	return res
+}
+
+func converterModelToScheduleException(exception models.PVZScheduleException) oapi.ScheduleException {
+	return oapi.ScheduleException{
+		Date:      types.Date{Time: exception.Date},
+		IsClosed:  exception.IsClosed,
+		OpenTime:  exception.OpenTime,
+		CloseTime: exception.CloseTime,
+	}
+}
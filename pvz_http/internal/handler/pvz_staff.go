@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func (hdl *Handler) GetPvzPvzIdStaff(ctx *gin.Context, pvzId types.UUID) {
+	staff, err := hdl.appService.PVZStaff.GetPVZStaff(ctx, pvzId)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get pvz staff")
+		ctx.JSON(staffErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToStaff(staff))
+}
+
+func (hdl *Handler) PostPvzPvzIdStaff(ctx *gin.Context, pvzId types.UUID) {
+	var req oapi.PostPvzPvzIdStaffJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	staff, err := hdl.appService.PVZStaff.AssignEmployee(ctx, pvzId, req.UserId)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to assign employee")
+		ctx.JSON(staffErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, converterModelToStaff(staff))
+}
+
+func (hdl *Handler) DeletePvzPvzIdStaffUserId(ctx *gin.Context, pvzId types.UUID, userId types.UUID) {
+	if err := hdl.appService.PVZStaff.UnassignEmployee(ctx, pvzId, userId); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to unassign employee")
+		ctx.JSON(staffErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func staffErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrUserNotEmployee):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrPVZNotFound),
+		errors.Is(err, service.ErrEmployeeNotFound),
+		errors.Is(err, service.ErrEmployeeNotAssigned):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrEmployeeAlreadyAssigned):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func converterModelToStaff(staff []models.PVZStaff) []oapi.PVZStaff {
+	res := make([]oapi.PVZStaff, len(staff))
+	for idx, value := range staff {
+		res[idx] = oapi.PVZStaff{
+			PvzId:      value.PVZId,
+			UserId:     value.UserId,
+			Email:      types.Email(value.Email),
+			AssignedAt: value.AssignedAt,
+		}
+	}
+
+	return res
+}
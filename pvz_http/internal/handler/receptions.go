@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
-	"github.com/MaksimovDenis/avito_pvz/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime/types"
 )
@@ -18,13 +21,6 @@ func (hdl *Handler) PostReceptions(ctx *gin.Context) {
 		return
 	}
 
-	if !employeeRole(claims.(*token.UserClaims).Role) {
-		hdl.log.Error().Msg("user is not employee")
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "у пользователя нет прав"})
-
-		return
-	}
-
 	var req oapi.PostReceptionsJSONBody
 
 	if err := ctx.BindJSON(&req); err != nil {
@@ -38,6 +34,16 @@ func (hdl *Handler) PostReceptions(ctx *gin.Context) {
 	pvzId := req.PvzId
 
 	res, err := hdl.appService.Reception.CreateReception(ctx, userId, pvzId)
+	if errors.Is(err, service.ErrPVZAccessDenied) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	if errors.Is(err, service.ErrPVZNotActive) || errors.Is(err, service.ErrPVZClosedNow) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to create new reception")
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -64,14 +70,12 @@ func (hdl *Handler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, uuid types.
 		return
 	}
 
-	if !employeeRole(claims.(*token.UserClaims).Role) {
-		hdl.log.Error().Msg("user is not employee")
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "у пользователя нет прав"})
-
+	res, err := hdl.appService.Reception.CloseReceptionByPVZId(ctx, claims.(*token.UserClaims).ID, uuid)
+	if errors.Is(err, service.ErrPVZAccessDenied) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		return
 	}
 
-	res, err := hdl.appService.Reception.CloseReceptionByPVZId(ctx, uuid)
 	if err != nil {
 		hdl.log.Error().Err(err).Msg("failed to close reception")
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -88,3 +92,138 @@ func (hdl *Handler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, uuid types.
 
 	ctx.JSON(http.StatusOK, resOapi)
 }
+
+func (hdl *Handler) GetReceptionsReceptionId(ctx *gin.Context, receptionId types.UUID,
+	params oapi.GetReceptionsReceptionIdParams) {
+	req := models.GetReceptionReq{
+		ReceptionId: receptionId,
+	}
+
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+
+	if params.Cursor != nil {
+		req.Cursor = *params.Cursor
+	}
+
+	res, err := hdl.appService.Reception.GetReception(ctx, req)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get reception")
+		ctx.JSON(receptionErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	if !hdl.checkReceptionScope(ctx, res.Reception.PvzId) {
+		return
+	}
+
+	products := make([]oapi.Product, len(res.Products))
+	for idx, product := range res.Products {
+		products[idx] = oapi.Product{
+			DateTime:    &product.CreatedAt,
+			Id:          &product.Id,
+			ReceptionId: res.Reception.Id,
+			Type:        product.ProductType,
+		}
+	}
+
+	if res.NextCursor != "" {
+		ctx.Header(nextCursorHeader, res.NextCursor)
+	}
+
+	ctx.JSON(http.StatusOK, oapi.ReceptionWithProducts{
+		Reception: converterModelToReceptionDetails(res.Reception),
+		Products:  products,
+	})
+}
+
+func (hdl *Handler) GetPvzPvzIdReceptions(ctx *gin.Context, pvzId types.UUID, params oapi.GetPvzPvzIdReceptionsParams) {
+	req := models.GetReceptionsReq{
+		PvzId: pvzId,
+		From:  params.From,
+		To:    params.To,
+	}
+
+	if params.Status != nil {
+		req.Status = string(*params.Status)
+	}
+
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+
+	if params.Cursor != nil {
+		req.Cursor = *params.Cursor
+	}
+
+	res, err := hdl.appService.Reception.GetReceptions(ctx, req)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get receptions")
+		ctx.JSON(receptionErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	receptions := make([]oapi.ReceptionDetails, len(res.Receptions))
+	for idx, reception := range res.Receptions {
+		receptions[idx] = converterModelToReceptionDetails(reception)
+	}
+
+	if res.NextCursor != "" {
+		ctx.Header(nextCursorHeader, res.NextCursor)
+	}
+
+	ctx.JSON(http.StatusOK, receptions)
+}
+
+// checkReceptionScope applies the PVZ restrictions of GetPermissionMiddlewareFunc
+// to a route that finds out the PVZ only from the reception.
+func (hdl *Handler) checkReceptionScope(ctx *gin.Context, pvzId types.UUID) bool {
+	if value, ok := ctx.Get(apiKeyContextKey); ok {
+		apiKey := value.(models.APIKey)
+		if apiKey.PvzId != nil && *apiKey.PvzId != pvzId {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": service.ErrPVZAccessDenied.Error()})
+			return false
+		}
+
+		return true
+	}
+
+	if city := ctx.GetString(cityScopeKey); city != "" {
+		if err := hdl.appService.Access.CheckPVZScope(ctx, pvzId, city); err != nil {
+			ctx.JSON(accessErrorStatus(err), gin.H{"error": err.Error()})
+			return false
+		}
+	}
+
+	return true
+}
+
+func converterModelToReceptionDetails(reception models.ReceptionDetails) oapi.ReceptionDetails {
+	return oapi.ReceptionDetails{
+		Id:            reception.Id,
+		PvzId:         reception.PvzId,
+		Status:        oapi.ReceptionDetailsStatus(reception.Status),
+		DateTime:      reception.CreatedAt,
+		CreatedBy:     reception.CreatedBy,
+		ClosedAt:      reception.ClosedAt,
+		ClosedBy:      reception.ClosedBy,
+		ProductCounts: reception.ProductCounts,
+	}
+}
+
+func receptionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidPageToken),
+		errors.Is(err, service.ErrInvalidReceptionStatus),
+		errors.Is(err, service.ErrInvalidDateRange):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrReceptionNotFound),
+		errors.Is(err, service.ErrPVZNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
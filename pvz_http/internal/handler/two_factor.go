@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+)
+
+func (hdl *Handler) PostLogin2fa(ctx *gin.Context) {
+	var req oapi.PostLogin2faJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	tokens, err := hdl.appService.TwoFactor.LoginTwoFactor(ctx, models.TwoFactorLoginReq{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+	})
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to complete two-factor login")
+		ctx.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, oapi.TokenPair{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	})
+}
+
+func (hdl *Handler) PostLogin2faEnroll(ctx *gin.Context) {
+	var req oapi.PostLogin2faEnrollJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	enrollment, err := hdl.appService.TwoFactor.EnrollTwoFactorByChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to enroll two-factor")
+		ctx.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToEnrollment(enrollment))
+}
+
+func (hdl *Handler) PostMe2faEnroll(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	enrollment, err := hdl.appService.TwoFactor.EnrollTwoFactor(ctx, claims.(*token.UserClaims).ID)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to enroll two-factor")
+		ctx.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToEnrollment(enrollment))
+}
+
+func (hdl *Handler) PostMe2faConfirm(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	var req oapi.PostMe2faConfirmJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	if err := hdl.appService.TwoFactor.ConfirmTwoFactor(ctx, claims.(*token.UserClaims).ID, req.Code); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to confirm two-factor")
+		ctx.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (hdl *Handler) PostMe2faDisable(ctx *gin.Context) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	var req oapi.PostMe2faDisableJSONBody
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	if err := hdl.appService.TwoFactor.DisableTwoFactor(ctx, claims.(*token.UserClaims).ID, req.Code); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to disable two-factor")
+		ctx.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func converterModelToEnrollment(enrollment models.TwoFactorEnrollmentRes) oapi.TwoFactorEnrollment {
+	return oapi.TwoFactorEnrollment{
+		Secret:        enrollment.Secret,
+		OtpauthUri:    enrollment.URI,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}
+}
+
+func twoFactorErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrTwoFactorCodeRequired),
+		errors.Is(err, service.ErrInvalidTwoFactorCode),
+		errors.Is(err, service.ErrTwoFactorNotEnrolled),
+		errors.Is(err, service.ErrTwoFactorNotEnabled):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrInvalidChallenge):
+		return http.StatusUnauthorized
+	case errors.Is(err, service.ErrUserDisabled),
+		errors.Is(err, service.ErrTwoFactorRequired):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrAccountNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrTwoFactorAlreadyEnabled):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrTooManyLoginAttempts):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	oapi "github.com/MaksimovDenis/avito_pvz/pkg/protocol"
+	"github.com/MaksimovDenis/pvz_core/models"
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
+	"github.com/MaksimovDenis/pvz_core/service"
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func (hdl *Handler) GetUsers(ctx *gin.Context, params oapi.GetUsersParams) {
+	req := models.GetUsersReq{
+		Disabled: params.Disabled,
+	}
+
+	if params.Role != nil {
+		req.Role = *params.Role
+	}
+
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+
+	res, err := hdl.appService.Users.GetUsers(ctx, req)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get users")
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Header(totalCountHeader, strconv.Itoa(res.Total))
+	if res.NextPage > 0 {
+		ctx.Header(nextPageHeader, strconv.Itoa(res.NextPage))
+	}
+
+	users := make([]oapi.User, len(res.Users))
+	for idx, user := range res.Users {
+		users[idx] = converterModelToUser(user)
+	}
+
+	ctx.JSON(http.StatusOK, users)
+}
+
+func (hdl *Handler) GetUsersUserId(ctx *gin.Context, userId types.UUID) {
+	user, err := hdl.appService.Users.GetUser(ctx, userId)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to get user")
+		ctx.JSON(userErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToUser(user))
+}
+
+func (hdl *Handler) PatchUsersUserId(ctx *gin.Context, userId types.UUID) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	var req oapi.UserUpdate
+
+	if err := ctx.BindJSON(&req); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to parse request body")
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Неверный запрос"})
+
+		return
+	}
+
+	reqModel := models.UpdateUserReq{
+		City:     req.City,
+		Disabled: req.Disabled,
+	}
+
+	if req.Role != nil {
+		role := string(*req.Role)
+		reqModel.Role = &role
+	}
+
+	user, err := hdl.appService.Users.UpdateUser(ctx, claims.(*token.UserClaims).ID, userId, reqModel)
+	if err != nil {
+		hdl.log.Error().Err(err).Msg("failed to update user")
+		ctx.JSON(userErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.JSON(http.StatusOK, converterModelToUser(user))
+}
+
+func (hdl *Handler) DeleteUsersUserId(ctx *gin.Context, userId types.UUID) {
+	claims, ok := ctx.Get("user")
+	if !ok {
+		hdl.log.Error().Msg("user claims not found in context")
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Неавторизован"})
+
+		return
+	}
+
+	if err := hdl.appService.Users.DeleteUser(ctx, claims.(*token.UserClaims).ID, userId); err != nil {
+		hdl.log.Error().Err(err).Msg("failed to delete user")
+		ctx.JSON(userErrorStatus(err), gin.H{"error": err.Error()})
+
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func userErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrUserNoChanges),
+		errors.Is(err, service.ErrSelfModification),
+		errors.Is(err, service.ErrInvalidRole),
+		errors.Is(err, service.ErrCityRequired),
+		errors.Is(err, service.ErrCityNotSupported):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrAccountNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func converterModelToUser(user models.User) oapi.User {
+	return oapi.User{
+		Id:               &user.Id,
+		Email:            types.Email(user.Email),
+		Role:             oapi.UserRole(user.Role),
+		City:             user.City,
+		Disabled:         &user.Disabled,
+		TwoFactorEnabled: &user.TwoFactorEnabled,
+		EmailVerified:    &user.EmailVerified,
+		CreatedAt:        &user.CreatedAt,
+	}
+}
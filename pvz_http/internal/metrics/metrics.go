@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/MaksimovDenis/pvz_core/pkg/token"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -11,11 +12,9 @@ import (
 
 type Metrics struct {
 	httpRequestTotal             *prometheus.CounterVec
+	httpDummyRequestTotal        *prometheus.CounterVec
 	httpRequestDurationHistogram *prometheus.HistogramVec
 	httpRequestsDurationSummary  *prometheus.SummaryVec
-	PvzCountTotal                prometheus.Counter
-	ReceptionCountTotal          prometheus.Counter
-	ProductsCountTotal           prometheus.Counter
 }
 
 func New() *Metrics {
@@ -27,6 +26,14 @@ func New() *Metrics {
 		[]string{"path", "code"},
 	)
 
+	httpDummyRequestTotal := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_dummy_request_total",
+			Help: "The total amount of HTTP requests made with dummy login tokens by path and code",
+		},
+		[]string{"path", "code"},
+	)
+
 	httpRequestDurationHistogram := promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: "http_request_duration_seconds",
@@ -54,34 +61,11 @@ func New() *Metrics {
 		[]string{"path"},
 	)
 
-	pvzCountTotal := promauto.NewCounter(
-		prometheus.CounterOpts{
-			Name: "pvz_count_total",
-			Help: "The total amount of created PVZ",
-		},
-	)
-
-	receptionCountTotal := promauto.NewCounter(
-		prometheus.CounterOpts{
-			Name: "reception_count_total",
-			Help: "The total amount of created reception",
-		},
-	)
-
-	productsCountTotal := promauto.NewCounter(
-		prometheus.CounterOpts{
-			Name: "products_count_total",
-			Help: "The total amount of added products",
-		},
-	)
-
 	return &Metrics{
 		httpRequestTotal:             httpRequestTotal,
+		httpDummyRequestTotal:        httpDummyRequestTotal,
 		httpRequestDurationHistogram: httpRequestDurationHistogram,
 		httpRequestsDurationSummary:  httpRequestsDurationSummary,
-		PvzCountTotal:                pvzCountTotal,
-		ReceptionCountTotal:          receptionCountTotal,
-		ProductsCountTotal:           productsCountTotal,
 	}
 }
 
@@ -96,6 +80,10 @@ func (hdl *Metrics) HTTPMetrics() gin.HandlerFunc {
 		code := strconv.Itoa(ctx.Writer.Status())
 
 		hdl.httpRequestTotal.WithLabelValues(path, code).Inc()
+
+		if claims, ok := ctx.Get("user"); ok && claims.(*token.UserClaims).Dummy {
+			hdl.httpDummyRequestTotal.WithLabelValues(path, code).Inc()
+		}
 		hdl.httpRequestDurationHistogram.WithLabelValues(path).Observe(duration)
 	}
 }
@@ -24,33 +24,51 @@ import (
 )
 
 const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for PVZStatus.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	PVZStatusActive    PVZStatus = "active"
+	PVZStatusClosed    PVZStatus = "closed"
+	PVZStatusSuspended PVZStatus = "suspended"
 )
 
-// Defines values for ProductType.
+// Defines values for PVZUpdateStatus.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	PVZUpdateStatusActive    PVZUpdateStatus = "active"
+	PVZUpdateStatusClosed    PVZUpdateStatus = "closed"
+	PVZUpdateStatusSuspended PVZUpdateStatus = "suspended"
 )
 
 // Defines values for ReceptionStatus.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
+	ReceptionStatusClose      ReceptionStatus = "close"
+	ReceptionStatusInProgress ReceptionStatus = "in_progress"
+)
+
+// Defines values for ReceptionDetailsStatus.
+const (
+	ReceptionDetailsStatusClose      ReceptionDetailsStatus = "close"
+	ReceptionDetailsStatusInProgress ReceptionDetailsStatus = "in_progress"
 )
 
 // Defines values for UserRole.
 const (
-	UserRoleEmployee  UserRole = "employee"
-	UserRoleModerator UserRole = "moderator"
+	UserRoleAuditor         UserRole = "auditor"
+	UserRoleEmployee        UserRole = "employee"
+	UserRoleIntegration     UserRole = "integration"
+	UserRoleModerator       UserRole = "moderator"
+	UserRoleRegionalManager UserRole = "regional_manager"
+)
+
+// Defines values for UserUpdateRole.
+const (
+	UserUpdateRoleAuditor         UserUpdateRole = "auditor"
+	UserUpdateRoleEmployee        UserUpdateRole = "employee"
+	UserUpdateRoleModerator       UserUpdateRole = "moderator"
+	UserUpdateRoleRegionalManager UserUpdateRole = "regional_manager"
 )
 
 // Defines values for PostDummyLoginJSONBodyRole.
@@ -59,44 +77,218 @@ const (
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for PostInvitesJSONBodyRole.
+const (
+	PostInvitesJSONBodyRoleAuditor         PostInvitesJSONBodyRole = "auditor"
+	PostInvitesJSONBodyRoleEmployee        PostInvitesJSONBodyRole = "employee"
+	PostInvitesJSONBodyRoleModerator       PostInvitesJSONBodyRole = "moderator"
+	PostInvitesJSONBodyRoleRegionalManager PostInvitesJSONBodyRole = "regional_manager"
+)
+
+// Defines values for GetPvzParamsPvzStatus.
+const (
+	Active    GetPvzParamsPvzStatus = "active"
+	Closed    GetPvzParamsPvzStatus = "closed"
+	Suspended GetPvzParamsPvzStatus = "suspended"
+)
+
+// Defines values for GetPvzParamsStatus.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	GetPvzParamsStatusClose      GetPvzParamsStatus = "close"
+	GetPvzParamsStatusInProgress GetPvzParamsStatus = "in_progress"
+)
+
+// Defines values for GetPvzPvzIdReceptionsParamsStatus.
+const (
+	GetPvzPvzIdReceptionsParamsStatusClose      GetPvzPvzIdReceptionsParamsStatus = "close"
+	GetPvzPvzIdReceptionsParamsStatusInProgress GetPvzPvzIdReceptionsParamsStatus = "in_progress"
 )
 
 // Defines values for PostRegisterJSONBodyRole.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	Employee PostRegisterJSONBodyRole = "employee"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt   time.Time          `json:"createdAt"`
+	CreatedBy   openapi_types.UUID `json:"createdBy"`
+	ExpiresAt   *time.Time         `json:"expiresAt,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	LastUsedAt  *time.Time         `json:"lastUsedAt,omitempty"`
+	Name        string             `json:"name"`
+	Permissions []string           `json:"permissions"`
+
+	// Prefix Начало ключа, чтобы отличать ключи в списке
+	Prefix string `json:"prefix"`
+
+	// PvzId ПВЗ, которым ограничен ключ
+	PvzId     *openapi_types.UUID `json:"pvzId,omitempty"`
+	RevokedAt *time.Time          `json:"revokedAt,omitempty"`
+}
+
+// City defines model for City.
+type City struct {
+	CreatedAt  time.Time          `json:"createdAt"`
+	Id         openapi_types.UUID `json:"id"`
+	IsActive   bool               `json:"isActive"`
+	LaunchDate *time.Time         `json:"launchDate,omitempty"`
+	Name       string             `json:"name"`
+}
+
+// CityCreate defines model for CityCreate.
+type CityCreate struct {
+	IsActive   *bool      `json:"isActive,omitempty"`
+	LaunchDate *time.Time `json:"launchDate,omitempty"`
+	Name       string     `json:"name"`
+}
+
+// CityUpdate defines model for CityUpdate.
+type CityUpdate struct {
+	IsActive   *bool      `json:"isActive,omitempty"`
+	LaunchDate *time.Time `json:"launchDate,omitempty"`
+}
+
+// CreatedAPIKey defines model for CreatedAPIKey.
+type CreatedAPIKey struct {
+	ApiKey APIKey `json:"apiKey"`
+
+	// Key Значение ключа для заголовка X-API-Key
+	Key string `json:"key"`
+}
+
+// CreatedInvite defines model for CreatedInvite.
+type CreatedInvite struct {
+	Invite Invite `json:"invite"`
+
+	// Token Токен приглашения для регистрации, возвращается только один раз
+	Token string `json:"token"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// Invite defines model for Invite.
+type Invite struct {
+	City      *string            `json:"city,omitempty"`
+	CreatedAt time.Time          `json:"createdAt"`
+	CreatedBy openapi_types.UUID `json:"createdBy"`
+
+	// Email Почта, для которой выдано приглашение
+	Email     *openapi_types.Email `json:"email,omitempty"`
+	ExpiresAt time.Time            `json:"expiresAt"`
+	Id        openapi_types.UUID   `json:"id"`
+	RevokedAt *time.Time           `json:"revokedAt,omitempty"`
+	Role      string               `json:"role"`
+	UsedAt    *time.Time           `json:"usedAt,omitempty"`
+	UsedBy    *openapi_types.UUID  `json:"usedBy,omitempty"`
+}
+
+// NearbyPVZ defines model for NearbyPVZ.
+type NearbyPVZ struct {
+	// Distance Расстояние до ПВЗ в метрах
+	Distance float64 `json:"distance"`
+	Pvz      PVZ     `json:"pvz"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity             `json:"city"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
+	// Address Адрес ПВЗ
+	Address *string `json:"address,omitempty"`
+
+	// City Название города из справочника /cities
+	City string              `json:"city"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// IsOpenNow Работает ли ПВЗ сейчас, отсутствует для активного ПВЗ без графика работы
+	IsOpenNow        *bool      `json:"isOpenNow,omitempty"`
+	Lat              *float64   `json:"lat,omitempty"`
+	Lon              *float64   `json:"lon,omitempty"`
+	RegistrationDate *time.Time `json:"registrationDate,omitempty"`
+	Status           *PVZStatus `json:"status,omitempty"`
+}
+
+// PVZStatus defines model for PVZ.Status.
+type PVZStatus string
+
+// PVZSchedule defines model for PVZSchedule.
+type PVZSchedule struct {
+	Days []ScheduleDay `json:"days"`
+
+	// Exceptions Исключения начиная с сегодняшнего дня
+	Exceptions []ScheduleException `json:"exceptions"`
+	PvzId      openapi_types.UUID  `json:"pvzId"`
+}
+
+// PVZScheduleUpdate defines model for PVZScheduleUpdate.
+type PVZScheduleUpdate struct {
+	// Days Недельный график, пустой список удаляет график
+	Days []ScheduleDay `json:"days"`
+}
+
+// PVZStaff defines model for PVZStaff.
+type PVZStaff struct {
+	AssignedAt time.Time           `json:"assignedAt"`
+	Email      openapi_types.Email `json:"email"`
+	PvzId      openapi_types.UUID  `json:"pvzId"`
+	UserId     openapi_types.UUID  `json:"userId"`
+}
+
+// PVZUpdate defines model for PVZUpdate.
+type PVZUpdate struct {
+	// Address Адрес ПВЗ
+	Address *string          `json:"address,omitempty"`
+	City    *string          `json:"city,omitempty"`
+	Lat     *float64         `json:"lat,omitempty"`
+	Lon     *float64         `json:"lon,omitempty"`
+	Status  *PVZUpdateStatus `json:"status,omitempty"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZUpdateStatus defines model for PVZUpdate.Status.
+type PVZUpdateStatus string
 
 // Product defines model for Product.
 type Product struct {
 	DateTime    *time.Time          `json:"dateTime,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty"`
 	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+
+	// Type Код типа товара из справочника /product-types
+	Type string `json:"type"`
+}
+
+// ProductType defines model for ProductType.
+type ProductType struct {
+	Code        string    `json:"code"`
+	CreatedAt   time.Time `json:"createdAt"`
+	DisplayName string    `json:"displayName"`
+
+	// Fragile Хрупкий товар
+	Fragile  bool `json:"fragile"`
+	IsActive bool `json:"isActive"`
+
+	// Oversized Крупногабаритный товар
+	Oversized bool `json:"oversized"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductTypeCreate defines model for ProductTypeCreate.
+type ProductTypeCreate struct {
+	Code        string `json:"code"`
+	DisplayName string `json:"displayName"`
+	Fragile     *bool  `json:"fragile,omitempty"`
+	IsActive    *bool  `json:"isActive,omitempty"`
+	Oversized   *bool  `json:"oversized,omitempty"`
+}
+
+// ProductTypeUpdate defines model for ProductTypeUpdate.
+type ProductTypeUpdate struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Fragile     *bool   `json:"fragile,omitempty"`
+	IsActive    *bool   `json:"isActive,omitempty"`
+	Oversized   *bool   `json:"oversized,omitempty"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
@@ -109,19 +301,128 @@ type Reception struct {
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionDetails defines model for ReceptionDetails.
+type ReceptionDetails struct {
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// ClosedBy Пользователь, закрывший приемку
+	ClosedBy *openapi_types.UUID `json:"closedBy,omitempty"`
+
+	// CreatedBy Пользователь, открывший приемку
+	CreatedBy openapi_types.UUID `json:"createdBy"`
+
+	// DateTime Время открытия приемки
+	DateTime time.Time          `json:"dateTime"`
+	Id       openapi_types.UUID `json:"id"`
+
+	// ProductCounts Количество товаров приемки по типам
+	ProductCounts map[string]int         `json:"productCounts"`
+	PvzId         openapi_types.UUID     `json:"pvzId"`
+	Status        ReceptionDetailsStatus `json:"status"`
+}
+
+// ReceptionDetailsStatus defines model for ReceptionDetails.Status.
+type ReceptionDetailsStatus string
+
+// ReceptionWithProducts defines model for ReceptionWithProducts.
+type ReceptionWithProducts struct {
+	Products  []Product        `json:"products"`
+	Reception ReceptionDetails `json:"reception"`
+}
+
+// ScheduleDay defines model for ScheduleDay.
+type ScheduleDay struct {
+	CloseTime string `json:"closeTime"`
+	OpenTime  string `json:"openTime"`
+
+	// Weekday День недели, 1 — понедельник, 7 — воскресенье
+	Weekday int `json:"weekday"`
+}
+
+// ScheduleException defines model for ScheduleException.
+type ScheduleException struct {
+	CloseTime *string            `json:"closeTime,omitempty"`
+	Date      openapi_types.Date `json:"date"`
+	IsClosed  bool               `json:"isClosed"`
+	OpenTime  *string            `json:"openTime,omitempty"`
+}
+
+// ScheduleExceptionUpdate defines model for ScheduleExceptionUpdate.
+type ScheduleExceptionUpdate struct {
+	CloseTime *string `json:"closeTime,omitempty"`
+	IsClosed  bool    `json:"isClosed"`
+
+	// OpenTime Обязательно, если ПВЗ в этот день работает
+	OpenTime *string `json:"openTime,omitempty"`
+}
+
 // Token defines model for Token.
 type Token = string
 
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// TwoFactorChallenge Второй шаг входа, завершается через /login/2fa
+type TwoFactorChallenge struct {
+	ChallengeToken string `json:"challengeToken"`
+
+	// EnrollmentRequired Роль требует 2FA, но она ещё не настроена, сначала вызовите /login/2fa/enroll
+	EnrollmentRequired bool      `json:"enrollmentRequired"`
+	ExpiresAt          time.Time `json:"expiresAt"`
+}
+
+// TwoFactorEnrollment defines model for TwoFactorEnrollment.
+type TwoFactorEnrollment struct {
+	// OtpauthUri URI для приложения-аутентификатора, обычно показывается QR-кодом
+	OtpauthUri string `json:"otpauthUri"`
+
+	// RecoveryCodes Одноразовые коды восстановления, показываются только один раз
+	RecoveryCodes []string `json:"recoveryCodes"`
+
+	// Secret Секрет TOTP в base32
+	Secret string `json:"secret"`
+}
+
 // User defines model for User.
 type User struct {
-	Email openapi_types.Email `json:"email"`
-	Id    *openapi_types.UUID `json:"id,omitempty"`
-	Role  UserRole            `json:"role"`
+	// City Город, которым ограничена роль regional_manager
+	City          *string             `json:"city,omitempty"`
+	CreatedAt     *time.Time          `json:"createdAt,omitempty"`
+	Disabled      *bool               `json:"disabled,omitempty"`
+	Email         openapi_types.Email `json:"email"`
+	EmailVerified *bool               `json:"emailVerified,omitempty"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+
+	// Role integration — служебный пользователь API ключа
+	Role             UserRole `json:"role"`
+	TwoFactorEnabled *bool    `json:"twoFactorEnabled,omitempty"`
 }
 
-// UserRole defines model for User.Role.
+// UserRole integration — служебный пользователь API ключа
 type UserRole string
 
+// UserUpdate defines model for UserUpdate.
+type UserUpdate struct {
+	// City Обязателен при назначении роли regional_manager
+	City     *string         `json:"city,omitempty"`
+	Disabled *bool           `json:"disabled,omitempty"`
+	Role     *UserUpdateRole `json:"role,omitempty"`
+}
+
+// UserUpdateRole defines model for UserUpdate.Role.
+type UserUpdateRole string
+
+// PostApiKeysJSONBody defines parameters for PostApiKeys.
+type PostApiKeysJSONBody struct {
+	ExpiresAt   *time.Time          `json:"expiresAt,omitempty"`
+	Name        string              `json:"name"`
+	Permissions []string            `json:"permissions"`
+	PvzId       *openapi_types.UUID `json:"pvzId,omitempty"`
+}
+
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
 	Role PostDummyLoginJSONBodyRole `json:"role"`
@@ -130,34 +431,160 @@ type PostDummyLoginJSONBody struct {
 // PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBodyRole string
 
+// PostEmailVerifyJSONBody defines parameters for PostEmailVerify.
+type PostEmailVerifyJSONBody struct {
+	Token string `json:"token"`
+}
+
+// PostEmailVerifyResendJSONBody defines parameters for PostEmailVerifyResend.
+type PostEmailVerifyResendJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// PostInvitesJSONBody defines parameters for PostInvites.
+type PostInvitesJSONBody struct {
+	// City Обязателен для роли regional_manager, для остальных ролей игнорируется
+	City *string `json:"city,omitempty"`
+
+	// Email Если указана, приглашение отправляется на почту и действует только для неё
+	Email *openapi_types.Email    `json:"email,omitempty"`
+	Role  PostInvitesJSONBodyRole `json:"role"`
+}
+
+// PostInvitesJSONBodyRole defines parameters for PostInvites.
+type PostInvitesJSONBodyRole string
+
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// PostLogin2faJSONBody defines parameters for PostLogin2fa.
+type PostLogin2faJSONBody struct {
+	ChallengeToken string `json:"challengeToken"`
+	Code           string `json:"code"`
+}
+
+// PostLogin2faEnrollJSONBody defines parameters for PostLogin2faEnroll.
+type PostLogin2faEnrollJSONBody struct {
+	ChallengeToken string `json:"challengeToken"`
+}
+
+// PostLogoutJSONBody defines parameters for PostLogout.
+type PostLogoutJSONBody struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
+// PostMe2faConfirmJSONBody defines parameters for PostMe2faConfirm.
+type PostMe2faConfirmJSONBody struct {
+	Code string `json:"code"`
+}
+
+// PostMe2faDisableJSONBody defines parameters for PostMe2faDisable.
+type PostMe2faDisableJSONBody struct {
+	Code string `json:"code"`
+}
+
+// PostMePasswordJSONBody defines parameters for PostMePassword.
+type PostMePasswordJSONBody struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// PostPasswordResetJSONBody defines parameters for PostPasswordReset.
+type PostPasswordResetJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// PostPasswordResetConfirmJSONBody defines parameters for PostPasswordResetConfirm.
+type PostPasswordResetConfirmJSONBody struct {
+	NewPassword string `json:"newPassword"`
+	Token       string `json:"token"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
-}
+	PvzId openapi_types.UUID `json:"pvzId"`
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+	// Type Код типа товара из справочника /product-types
+	Type string `json:"type"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
-	// StartDate Начальная дата диапазона
+	// StartDate Начальная дата диапазона приемок, в ответ попадают только приемки из диапазона
 	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	// EndDate Конечная дата диапазона
+	// EndDate Конечная дата диапазона приемок
 	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
 
+	// City Город ПВЗ
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// PvzStatus Статус ПВЗ, по умолчанию закрытые ПВЗ не возвращаются
+	PvzStatus *GetPvzParamsPvzStatus `form:"pvzStatus,omitempty" json:"pvzStatus,omitempty"`
+
+	// Status Статус приемки, в ответ попадают только приемки с этим статусом
+	Status *GetPvzParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// ProductType Тип товара, в ответ попадают только приемки с товарами этого типа и только эти товары
+	ProductType *string `form:"productType,omitempty" json:"productType,omitempty"`
+
 	// Page Номер страницы
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из заголовка X-Next-Cursor, при его передаче page не учитывается
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPvzParamsPvzStatus defines parameters for GetPvz.
+type GetPvzParamsPvzStatus string
+
+// GetPvzParamsStatus defines parameters for GetPvz.
+type GetPvzParamsStatus string
+
+// GetPvzNearbyParams defines parameters for GetPvzNearby.
+type GetPvzNearbyParams struct {
+	// Lat Широта точки поиска
+	Lat float64 `form:"lat" json:"lat"`
+
+	// Lon Долгота точки поиска
+	Lon float64 `form:"lon" json:"lon"`
+
+	// Radius Радиус поиска в метрах
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
+
+	// Limit Максимальное количество ПВЗ в ответе
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParams defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParams struct {
+	Status *GetPvzPvzIdReceptionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From Начало периода открытия приемок
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода открытия приемок
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из заголовка X-Next-Cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParamsStatus defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParamsStatus string
+
+// PostPvzPvzIdStaffJSONBody defines parameters for PostPvzPvzIdStaff.
+type PostPvzPvzIdStaffJSONBody struct {
+	UserId openapi_types.UUID `json:"userId"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
@@ -165,34 +592,131 @@ type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// GetReceptionsReceptionIdParams defines parameters for GetReceptionsReceptionId.
+type GetReceptionsReceptionIdParams struct {
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из заголовка X-Next-Cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
-	Email    openapi_types.Email      `json:"email"`
-	Password string                   `json:"password"`
-	Role     PostRegisterJSONBodyRole `json:"role"`
+	Email openapi_types.Email `json:"email"`
+
+	// InviteToken Токен приглашения, роль и город берутся из приглашения
+	InviteToken *string `json:"inviteToken,omitempty"`
+	Password    string  `json:"password"`
+
+	// Role Без приглашения, если регистрация по приглашениям отключена, доступна только роль employee
+	Role *PostRegisterJSONBodyRole `json:"role,omitempty"`
 }
 
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// PostTokenRefreshJSONBody defines parameters for PostTokenRefresh.
+type PostTokenRefreshJSONBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	Role     *string `form:"role,omitempty" json:"role,omitempty"`
+	Disabled *bool   `form:"disabled,omitempty" json:"disabled,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
+type PostApiKeysJSONRequestBody PostApiKeysJSONBody
+
+// PostCitiesJSONRequestBody defines body for PostCities for application/json ContentType.
+type PostCitiesJSONRequestBody = CityCreate
+
+// PatchCitiesCityIdJSONRequestBody defines body for PatchCitiesCityId for application/json ContentType.
+type PatchCitiesCityIdJSONRequestBody = CityUpdate
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
+// PostEmailVerifyJSONRequestBody defines body for PostEmailVerify for application/json ContentType.
+type PostEmailVerifyJSONRequestBody PostEmailVerifyJSONBody
+
+// PostEmailVerifyResendJSONRequestBody defines body for PostEmailVerifyResend for application/json ContentType.
+type PostEmailVerifyResendJSONRequestBody PostEmailVerifyResendJSONBody
+
+// PostInvitesJSONRequestBody defines body for PostInvites for application/json ContentType.
+type PostInvitesJSONRequestBody PostInvitesJSONBody
+
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostLogin2faJSONRequestBody defines body for PostLogin2fa for application/json ContentType.
+type PostLogin2faJSONRequestBody PostLogin2faJSONBody
+
+// PostLogin2faEnrollJSONRequestBody defines body for PostLogin2faEnroll for application/json ContentType.
+type PostLogin2faEnrollJSONRequestBody PostLogin2faEnrollJSONBody
+
+// PostLogoutJSONRequestBody defines body for PostLogout for application/json ContentType.
+type PostLogoutJSONRequestBody PostLogoutJSONBody
+
+// PostMe2faConfirmJSONRequestBody defines body for PostMe2faConfirm for application/json ContentType.
+type PostMe2faConfirmJSONRequestBody PostMe2faConfirmJSONBody
+
+// PostMe2faDisableJSONRequestBody defines body for PostMe2faDisable for application/json ContentType.
+type PostMe2faDisableJSONRequestBody PostMe2faDisableJSONBody
+
+// PostMePasswordJSONRequestBody defines body for PostMePassword for application/json ContentType.
+type PostMePasswordJSONRequestBody PostMePasswordJSONBody
+
+// PostPasswordResetJSONRequestBody defines body for PostPasswordReset for application/json ContentType.
+type PostPasswordResetJSONRequestBody PostPasswordResetJSONBody
+
+// PostPasswordResetConfirmJSONRequestBody defines body for PostPasswordResetConfirm for application/json ContentType.
+type PostPasswordResetConfirmJSONRequestBody PostPasswordResetConfirmJSONBody
+
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody = ProductTypeCreate
+
+// PatchProductTypesCodeJSONRequestBody defines body for PatchProductTypesCode for application/json ContentType.
+type PatchProductTypesCodeJSONRequestBody = ProductTypeUpdate
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PatchPvzPvzIdJSONRequestBody defines body for PatchPvzPvzId for application/json ContentType.
+type PatchPvzPvzIdJSONRequestBody = PVZUpdate
+
+// PutPvzPvzIdScheduleJSONRequestBody defines body for PutPvzPvzIdSchedule for application/json ContentType.
+type PutPvzPvzIdScheduleJSONRequestBody = PVZScheduleUpdate
+
+// PutPvzPvzIdScheduleExceptionsDateJSONRequestBody defines body for PutPvzPvzIdScheduleExceptionsDate for application/json ContentType.
+type PutPvzPvzIdScheduleExceptionsDateJSONRequestBody = ScheduleExceptionUpdate
+
+// PostPvzPvzIdStaffJSONRequestBody defines body for PostPvzPvzIdStaff for application/json ContentType.
+type PostPvzPvzIdStaffJSONRequestBody PostPvzPvzIdStaffJSONBody
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostTokenRefreshJSONRequestBody defines body for PostTokenRefresh for application/json ContentType.
+type PostTokenRefreshJSONRequestBody PostTokenRefreshJSONBody
+
+// PatchUsersUserIdJSONRequestBody defines body for PatchUsersUserId for application/json ContentType.
+type PatchUsersUserIdJSONRequestBody = UserUpdate
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -266,16 +790,123 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetApiKeys request
+	GetApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiKeysWithBody request with any body
+	PostApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiKeys(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiKeysKeyId request
+	DeleteApiKeysKeyId(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCities request
+	GetCities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCitiesWithBody request with any body
+	PostCitiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCities(ctx context.Context, body PostCitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCitiesCityId request
+	DeleteCitiesCityId(ctx context.Context, cityId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchCitiesCityIdWithBody request with any body
+	PatchCitiesCityIdWithBody(ctx context.Context, cityId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchCitiesCityId(ctx context.Context, cityId openapi_types.UUID, body PatchCitiesCityIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostDummyLoginWithBody request with any body
 	PostDummyLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostDummyLogin(ctx context.Context, body PostDummyLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostEmailVerifyWithBody request with any body
+	PostEmailVerifyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEmailVerify(ctx context.Context, body PostEmailVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostEmailVerifyResendWithBody request with any body
+	PostEmailVerifyResendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostEmailVerifyResend(ctx context.Context, body PostEmailVerifyResendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInvites request
+	GetInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostInvitesWithBody request with any body
+	PostInvitesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostInvites(ctx context.Context, body PostInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInvitesInviteId request
+	DeleteInvitesInviteId(ctx context.Context, inviteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostLoginWithBody request with any body
 	PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostLogin2faWithBody request with any body
+	PostLogin2faWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLogin2fa(ctx context.Context, body PostLogin2faJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLogin2faEnrollWithBody request with any body
+	PostLogin2faEnrollWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLogin2faEnroll(ctx context.Context, body PostLogin2faEnrollJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLogoutWithBody request with any body
+	PostLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLogout(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMe2faConfirmWithBody request with any body
+	PostMe2faConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMe2faConfirm(ctx context.Context, body PostMe2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMe2faDisableWithBody request with any body
+	PostMe2faDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMe2faDisable(ctx context.Context, body PostMe2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMe2faEnroll request
+	PostMe2faEnroll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostMePasswordWithBody request with any body
+	PostMePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMePassword(ctx context.Context, body PostMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPasswordResetWithBody request with any body
+	PostPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPasswordReset(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPasswordResetConfirmWithBody request with any body
+	PostPasswordResetConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPasswordResetConfirm(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProductTypes request
+	GetProductTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostProductTypesWithBody request with any body
+	PostProductTypesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostProductTypes(ctx context.Context, body PostProductTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProductTypesCode request
+	DeleteProductTypesCode(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchProductTypesCodeWithBody request with any body
+	PatchProductTypesCodeWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchProductTypesCode(ctx context.Context, code string, body PatchProductTypesCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostProductsWithBody request with any body
 	PostProductsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -289,25 +920,91 @@ type ClientInterface interface {
 
 	PostPvz(ctx context.Context, body PostPvzJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPvzNearby request
+	GetPvzNearby(ctx context.Context, params *GetPvzNearbyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePvzPvzId request
+	DeletePvzPvzId(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchPvzPvzIdWithBody request with any body
+	PatchPvzPvzIdWithBody(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchPvzPvzId(ctx context.Context, pvzId openapi_types.UUID, body PatchPvzPvzIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPvzPvzIdCloseLastReception request
 	PostPvzPvzIdCloseLastReception(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostPvzPvzIdDeactivate request
+	PostPvzPvzIdDeactivate(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostPvzPvzIdDeleteLastProduct request
 	PostPvzPvzIdDeleteLastProduct(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPvzPvzIdReceptions request
+	GetPvzPvzIdReceptions(ctx context.Context, pvzId openapi_types.UUID, params *GetPvzPvzIdReceptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPvzPvzIdSchedule request
+	GetPvzPvzIdSchedule(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutPvzPvzIdScheduleWithBody request with any body
+	PutPvzPvzIdScheduleWithBody(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutPvzPvzIdSchedule(ctx context.Context, pvzId openapi_types.UUID, body PutPvzPvzIdScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePvzPvzIdScheduleExceptionsDate request
+	DeletePvzPvzIdScheduleExceptionsDate(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutPvzPvzIdScheduleExceptionsDateWithBody request with any body
+	PutPvzPvzIdScheduleExceptionsDateWithBody(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutPvzPvzIdScheduleExceptionsDate(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, body PutPvzPvzIdScheduleExceptionsDateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPvzPvzIdStaff request
+	GetPvzPvzIdStaff(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPvzPvzIdStaffWithBody request with any body
+	PostPvzPvzIdStaffWithBody(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPvzPvzIdStaff(ctx context.Context, pvzId openapi_types.UUID, body PostPvzPvzIdStaffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePvzPvzIdStaffUserId request
+	DeletePvzPvzIdStaffUserId(ctx context.Context, pvzId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostReceptionsWithBody request with any body
 	PostReceptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostReceptions(ctx context.Context, body PostReceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetReceptionsReceptionId request
+	GetReceptionsReceptionId(ctx context.Context, receptionId openapi_types.UUID, params *GetReceptionsReceptionIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostRegisterWithBody request with any body
 	PostRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostRegister(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTokenRefreshWithBody request with any body
+	PostTokenRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTokenRefresh(ctx context.Context, body PostTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsers request
+	GetUsers(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersUserId request
+	DeleteUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersUserId request
+	GetUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchUsersUserIdWithBody request with any body
+	PatchUsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchUsersUserId(ctx context.Context, userId openapi_types.UUID, body PatchUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostDummyLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostDummyLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiKeysRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -318,8 +1015,8 @@ func (c *Client) PostDummyLoginWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostDummyLogin(ctx context.Context, body PostDummyLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostDummyLoginRequest(c.Server, body)
+func (c *Client) PostApiKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiKeysRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -330,8 +1027,8 @@ func (c *Client) PostDummyLogin(ctx context.Context, body PostDummyLoginJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostApiKeys(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiKeysRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -342,8 +1039,8 @@ func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLoginRequest(c.Server, body)
+func (c *Client) DeleteApiKeysKeyId(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiKeysKeyIdRequest(c.Server, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -354,8 +1051,8 @@ func (c *Client) PostLogin(ctx context.Context, body PostLoginJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostProductsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostProductsRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetCities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCitiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -366,8 +1063,8 @@ func (c *Client) PostProductsWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostProducts(ctx context.Context, body PostProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostProductsRequest(c.Server, body)
+func (c *Client) PostCitiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCitiesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -378,8 +1075,8 @@ func (c *Client) PostProducts(ctx context.Context, body PostProductsJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPvz(ctx context.Context, params *GetPvzParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPvzRequest(c.Server, params)
+func (c *Client) PostCities(ctx context.Context, body PostCitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCitiesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -390,8 +1087,8 @@ func (c *Client) GetPvz(ctx context.Context, params *GetPvzParams, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPvzWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPvzRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteCitiesCityId(ctx context.Context, cityId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCitiesCityIdRequest(c.Server, cityId)
 	if err != nil {
 		return nil, err
 	}
@@ -402,8 +1099,8 @@ func (c *Client) PostPvzWithBody(ctx context.Context, contentType string, body i
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPvz(ctx context.Context, body PostPvzJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPvzRequest(c.Server, body)
+func (c *Client) PatchCitiesCityIdWithBody(ctx context.Context, cityId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCitiesCityIdRequestWithBody(c.Server, cityId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -414,8 +1111,8 @@ func (c *Client) PostPvz(ctx context.Context, body PostPvzJSONRequestBody, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPvzPvzIdCloseLastReception(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPvzPvzIdCloseLastReceptionRequest(c.Server, pvzId)
+func (c *Client) PatchCitiesCityId(ctx context.Context, cityId openapi_types.UUID, body PatchCitiesCityIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCitiesCityIdRequest(c.Server, cityId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -426,8 +1123,8 @@ func (c *Client) PostPvzPvzIdCloseLastReception(ctx context.Context, pvzId opena
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostPvzPvzIdDeleteLastProduct(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostPvzPvzIdDeleteLastProductRequest(c.Server, pvzId)
+func (c *Client) PostDummyLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDummyLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -438,8 +1135,8 @@ func (c *Client) PostPvzPvzIdDeleteLastProduct(ctx context.Context, pvzId openap
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostReceptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostReceptionsRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostDummyLogin(ctx context.Context, body PostDummyLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDummyLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -450,8 +1147,8 @@ func (c *Client) PostReceptionsWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostReceptions(ctx context.Context, body PostReceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostReceptionsRequest(c.Server, body)
+func (c *Client) PostEmailVerifyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEmailVerifyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -462,8 +1159,8 @@ func (c *Client) PostReceptions(ctx context.Context, body PostReceptionsJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRegisterRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostEmailVerify(ctx context.Context, body PostEmailVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEmailVerifyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -474,8 +1171,8 @@ func (c *Client) PostRegisterWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostRegister(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostRegisterRequest(c.Server, body)
+func (c *Client) PostEmailVerifyResendWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEmailVerifyResendRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -486,1205 +1183,8663 @@ func (c *Client) PostRegister(ctx context.Context, body PostRegisterJSONRequestB
 	return c.Client.Do(req)
 }
 
-// NewPostDummyLoginRequest calls the generic PostDummyLogin builder with application/json body
-func NewPostDummyLoginRequest(server string, body PostDummyLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostEmailVerifyResend(ctx context.Context, body PostEmailVerifyResendJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostEmailVerifyResendRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostDummyLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostDummyLoginRequestWithBody generates requests for PostDummyLogin with any type of body
-func NewPostDummyLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvitesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dummyLogin")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostInvitesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInvitesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostLoginRequest calls the generic PostLogin builder with application/json body
-func NewPostLoginRequest(server string, body PostLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostInvites(ctx context.Context, body PostInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostInvitesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostLoginRequestWithBody generates requests for PostLogin with any type of body
-func NewPostLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteInvitesInviteId(ctx context.Context, inviteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInvitesInviteIdRequest(c.Server, inviteId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostProductsRequest calls the generic PostProducts builder with application/json body
-func NewPostProductsRequest(server string, body PostProductsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostProductsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostProductsRequestWithBody generates requests for PostProducts with any type of body
-func NewPostProductsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLogin2faWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogin2faRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/products")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostLogin2fa(ctx context.Context, body PostLogin2faJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogin2faRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetPvzRequest generates requests for GetPvz
-func NewGetPvzRequest(server string, params *GetPvzParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLogin2faEnrollWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogin2faEnrollRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pvz")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostLogin2faEnroll(ctx context.Context, body PostLogin2faEnrollJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogin2faEnrollRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.StartDate != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startDate", runtime.ParamLocationQuery, *params.StartDate); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.EndDate != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endDate", runtime.ParamLocationQuery, *params.EndDate); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPvzRequest calls the generic PostPvz builder with application/json body
-func NewPostPvzRequest(server string, body PostPvzJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostLogout(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogoutRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostPvzRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPvzRequestWithBody generates requests for PostPvz with any type of body
-func NewPostPvzRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostMe2faConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2faConfirmRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pvz")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostMe2faConfirm(ctx context.Context, body PostMe2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2faConfirmRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostPvzPvzIdCloseLastReceptionRequest generates requests for PostPvzPvzIdCloseLastReception
-func NewPostPvzPvzIdCloseLastReceptionRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+func (c *Client) PostMe2faDisableWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2faDisableRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostMe2faDisable(ctx context.Context, body PostMe2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2faDisableRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pvz/%s/close_last_reception", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostMe2faEnroll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMe2faEnrollRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostMePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostPvzPvzIdDeleteLastProductRequest generates requests for PostPvzPvzIdDeleteLastProduct
-func NewPostPvzPvzIdDeleteLastProductRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+func (c *Client) PostMePassword(ctx context.Context, body PostMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/pvz/%s/delete_last_product", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostPasswordReset(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostPasswordResetConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetConfirmRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostReceptionsRequest calls the generic PostReceptions builder with application/json body
-func NewPostReceptionsRequest(server string, body PostReceptionsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostPasswordResetConfirm(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetConfirmRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostReceptionsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostReceptionsRequestWithBody generates requests for PostReceptions with any type of body
-func NewPostReceptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetProductTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProductTypesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/receptions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostProductTypesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProductTypesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostProductTypes(ctx context.Context, body PostProductTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProductTypesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostRegisterRequest calls the generic PostRegister builder with application/json body
-func NewPostRegisterRequest(server string, body PostRegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteProductTypesCode(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProductTypesCodeRequest(c.Server, code)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostRegisterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostRegisterRequestWithBody generates requests for PostRegister with any type of body
-func NewPostRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchProductTypesCodeWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProductTypesCodeRequestWithBody(c.Server, code, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PatchProductTypesCode(ctx context.Context, code string, body PatchProductTypesCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProductTypesCodeRequest(c.Server, code, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostProductsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProductsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostProducts(ctx context.Context, body PostProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProductsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) GetPvz(ctx context.Context, params *GetPvzParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPvzRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PostPvzWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPvzRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostPvz(ctx context.Context, body PostPvzJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPvzRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) GetPvzNearby(ctx context.Context, params *GetPvzNearbyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPvzNearbyRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) DeletePvzPvzId(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePvzPvzIdRequest(c.Server, pvzId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PatchPvzPvzIdWithBody(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchPvzPvzIdRequestWithBody(c.Server, pvzId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
+func (c *Client) PatchPvzPvzId(ctx context.Context, pvzId openapi_types.UUID, body PatchPvzPvzIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchPvzPvzIdRequest(c.Server, pvzId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPvzPvzIdCloseLastReception(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPvzPvzIdCloseLastReceptionRequest(c.Server, pvzId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPvzPvzIdDeactivate(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPvzPvzIdDeactivateRequest(c.Server, pvzId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPvzPvzIdDeleteLastProduct(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPvzPvzIdDeleteLastProductRequest(c.Server, pvzId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPvzPvzIdReceptions(ctx context.Context, pvzId openapi_types.UUID, params *GetPvzPvzIdReceptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPvzPvzIdReceptionsRequest(c.Server, pvzId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPvzPvzIdSchedule(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPvzPvzIdScheduleRequest(c.Server, pvzId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPvzPvzIdScheduleWithBody(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPvzPvzIdScheduleRequestWithBody(c.Server, pvzId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPvzPvzIdSchedule(ctx context.Context, pvzId openapi_types.UUID, body PutPvzPvzIdScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPvzPvzIdScheduleRequest(c.Server, pvzId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePvzPvzIdScheduleExceptionsDate(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePvzPvzIdScheduleExceptionsDateRequest(c.Server, pvzId, date)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPvzPvzIdScheduleExceptionsDateWithBody(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPvzPvzIdScheduleExceptionsDateRequestWithBody(c.Server, pvzId, date, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutPvzPvzIdScheduleExceptionsDate(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, body PutPvzPvzIdScheduleExceptionsDateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutPvzPvzIdScheduleExceptionsDateRequest(c.Server, pvzId, date, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPvzPvzIdStaff(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPvzPvzIdStaffRequest(c.Server, pvzId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPvzPvzIdStaffWithBody(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPvzPvzIdStaffRequestWithBody(c.Server, pvzId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPvzPvzIdStaff(ctx context.Context, pvzId openapi_types.UUID, body PostPvzPvzIdStaffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPvzPvzIdStaffRequest(c.Server, pvzId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePvzPvzIdStaffUserId(ctx context.Context, pvzId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePvzPvzIdStaffUserIdRequest(c.Server, pvzId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostReceptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostReceptionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostReceptions(ctx context.Context, body PostReceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostReceptionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReceptionsReceptionId(ctx context.Context, receptionId openapi_types.UUID, params *GetReceptionsReceptionIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReceptionsReceptionIdRequest(c.Server, receptionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRegister(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTokenRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTokenRefreshRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTokenRefresh(ctx context.Context, body PostTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTokenRefreshRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsers(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersUserIdRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersUserIdRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchUsersUserIdWithBody(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsersUserIdRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchUsersUserId(ctx context.Context, userId openapi_types.UUID, body PatchUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchUsersUserIdRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetApiKeysRequest generates requests for GetApiKeys
+func NewGetApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiKeysRequest calls the generic PostApiKeys builder with application/json body
+func NewPostApiKeysRequest(server string, body PostApiKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiKeysRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiKeysRequestWithBody generates requests for PostApiKeys with any type of body
+func NewPostApiKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiKeysKeyIdRequest generates requests for DeleteApiKeysKeyId
+func NewDeleteApiKeysKeyIdRequest(server string, keyId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "keyId", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCitiesRequest generates requests for GetCities
+func NewGetCitiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCitiesRequest calls the generic PostCities builder with application/json body
+func NewPostCitiesRequest(server string, body PostCitiesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCitiesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostCitiesRequestWithBody generates requests for PostCities with any type of body
+func NewPostCitiesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCitiesCityIdRequest generates requests for DeleteCitiesCityId
+func NewDeleteCitiesCityIdRequest(server string, cityId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cityId", runtime.ParamLocationPath, cityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cities/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchCitiesCityIdRequest calls the generic PatchCitiesCityId builder with application/json body
+func NewPatchCitiesCityIdRequest(server string, cityId openapi_types.UUID, body PatchCitiesCityIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchCitiesCityIdRequestWithBody(server, cityId, "application/json", bodyReader)
+}
+
+// NewPatchCitiesCityIdRequestWithBody generates requests for PatchCitiesCityId with any type of body
+func NewPatchCitiesCityIdRequestWithBody(server string, cityId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cityId", runtime.ParamLocationPath, cityId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cities/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostDummyLoginRequest calls the generic PostDummyLogin builder with application/json body
+func NewPostDummyLoginRequest(server string, body PostDummyLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostDummyLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostDummyLoginRequestWithBody generates requests for PostDummyLogin with any type of body
+func NewPostDummyLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dummyLogin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostEmailVerifyRequest calls the generic PostEmailVerify builder with application/json body
+func NewPostEmailVerifyRequest(server string, body PostEmailVerifyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEmailVerifyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostEmailVerifyRequestWithBody generates requests for PostEmailVerify with any type of body
+func NewPostEmailVerifyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/email/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostEmailVerifyResendRequest calls the generic PostEmailVerifyResend builder with application/json body
+func NewPostEmailVerifyResendRequest(server string, body PostEmailVerifyResendJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostEmailVerifyResendRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostEmailVerifyResendRequestWithBody generates requests for PostEmailVerifyResend with any type of body
+func NewPostEmailVerifyResendRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/email/verify/resend")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInvitesRequest generates requests for GetInvites
+func NewGetInvitesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostInvitesRequest calls the generic PostInvites builder with application/json body
+func NewPostInvitesRequest(server string, body PostInvitesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostInvitesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostInvitesRequestWithBody generates requests for PostInvites with any type of body
+func NewPostInvitesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteInvitesInviteIdRequest generates requests for DeleteInvitesInviteId
+func NewDeleteInvitesInviteIdRequest(server string, inviteId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "inviteId", runtime.ParamLocationPath, inviteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostLoginRequest calls the generic PostLogin builder with application/json body
+func NewPostLoginRequest(server string, body PostLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLoginRequestWithBody generates requests for PostLogin with any type of body
+func NewPostLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLogin2faRequest calls the generic PostLogin2fa builder with application/json body
+func NewPostLogin2faRequest(server string, body PostLogin2faJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLogin2faRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLogin2faRequestWithBody generates requests for PostLogin2fa with any type of body
+func NewPostLogin2faRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/2fa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLogin2faEnrollRequest calls the generic PostLogin2faEnroll builder with application/json body
+func NewPostLogin2faEnrollRequest(server string, body PostLogin2faEnrollJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLogin2faEnrollRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLogin2faEnrollRequestWithBody generates requests for PostLogin2faEnroll with any type of body
+func NewPostLogin2faEnrollRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/2fa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLogoutRequest calls the generic PostLogout builder with application/json body
+func NewPostLogoutRequest(server string, body PostLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLogoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLogoutRequestWithBody generates requests for PostLogout with any type of body
+func NewPostLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostMe2faConfirmRequest calls the generic PostMe2faConfirm builder with application/json body
+func NewPostMe2faConfirmRequest(server string, body PostMe2faConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMe2faConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMe2faConfirmRequestWithBody generates requests for PostMe2faConfirm with any type of body
+func NewPostMe2faConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/2fa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostMe2faDisableRequest calls the generic PostMe2faDisable builder with application/json body
+func NewPostMe2faDisableRequest(server string, body PostMe2faDisableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMe2faDisableRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMe2faDisableRequestWithBody generates requests for PostMe2faDisable with any type of body
+func NewPostMe2faDisableRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/2fa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostMe2faEnrollRequest generates requests for PostMe2faEnroll
+func NewPostMe2faEnrollRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/2fa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMePasswordRequest calls the generic PostMePassword builder with application/json body
+func NewPostMePasswordRequest(server string, body PostMePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostMePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostMePasswordRequestWithBody generates requests for PostMePassword with any type of body
+func NewPostMePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPasswordResetRequest calls the generic PostPasswordReset builder with application/json body
+func NewPostPasswordResetRequest(server string, body PostPasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPasswordResetRequestWithBody generates requests for PostPasswordReset with any type of body
+func NewPostPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPasswordResetConfirmRequest calls the generic PostPasswordResetConfirm builder with application/json body
+func NewPostPasswordResetConfirmRequest(server string, body PostPasswordResetConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPasswordResetConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPasswordResetConfirmRequestWithBody generates requests for PostPasswordResetConfirm with any type of body
+func NewPostPasswordResetConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password/reset/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProductTypesRequest generates requests for GetProductTypes
+func NewGetProductTypesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/product-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostProductTypesRequest calls the generic PostProductTypes builder with application/json body
+func NewPostProductTypesRequest(server string, body PostProductTypesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostProductTypesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostProductTypesRequestWithBody generates requests for PostProductTypes with any type of body
+func NewPostProductTypesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/product-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProductTypesCodeRequest generates requests for DeleteProductTypesCode
+func NewDeleteProductTypesCodeRequest(server string, code string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/product-types/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchProductTypesCodeRequest calls the generic PatchProductTypesCode builder with application/json body
+func NewPatchProductTypesCodeRequest(server string, code string, body PatchProductTypesCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchProductTypesCodeRequestWithBody(server, code, "application/json", bodyReader)
+}
+
+// NewPatchProductTypesCodeRequestWithBody generates requests for PatchProductTypesCode with any type of body
+func NewPatchProductTypesCodeRequestWithBody(server string, code string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/product-types/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostProductsRequest calls the generic PostProducts builder with application/json body
+func NewPostProductsRequest(server string, body PostProductsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostProductsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostProductsRequestWithBody generates requests for PostProducts with any type of body
+func NewPostProductsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/products")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPvzRequest generates requests for GetPvz
+func NewGetPvzRequest(server string, params *GetPvzParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startDate", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endDate", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.City != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "city", runtime.ParamLocationQuery, *params.City); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PvzStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pvzStatus", runtime.ParamLocationQuery, *params.PvzStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProductType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "productType", runtime.ParamLocationQuery, *params.ProductType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPvzRequest calls the generic PostPvz builder with application/json body
+func NewPostPvzRequest(server string, body PostPvzJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPvzRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPvzRequestWithBody generates requests for PostPvz with any type of body
+func NewPostPvzRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPvzNearbyRequest generates requests for GetPvzNearby
+func NewGetPvzNearbyRequest(server string, params *GetPvzNearbyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/nearby")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lat", runtime.ParamLocationQuery, params.Lat); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lon", runtime.ParamLocationQuery, params.Lon); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Radius != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "radius", runtime.ParamLocationQuery, *params.Radius); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePvzPvzIdRequest generates requests for DeletePvzPvzId
+func NewDeletePvzPvzIdRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchPvzPvzIdRequest calls the generic PatchPvzPvzId builder with application/json body
+func NewPatchPvzPvzIdRequest(server string, pvzId openapi_types.UUID, body PatchPvzPvzIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchPvzPvzIdRequestWithBody(server, pvzId, "application/json", bodyReader)
+}
+
+// NewPatchPvzPvzIdRequestWithBody generates requests for PatchPvzPvzId with any type of body
+func NewPatchPvzPvzIdRequestWithBody(server string, pvzId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPvzPvzIdCloseLastReceptionRequest generates requests for PostPvzPvzIdCloseLastReception
+func NewPostPvzPvzIdCloseLastReceptionRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/close_last_reception", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPvzPvzIdDeactivateRequest generates requests for PostPvzPvzIdDeactivate
+func NewPostPvzPvzIdDeactivateRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/deactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPvzPvzIdDeleteLastProductRequest generates requests for PostPvzPvzIdDeleteLastProduct
+func NewPostPvzPvzIdDeleteLastProductRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/delete_last_product", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPvzPvzIdReceptionsRequest generates requests for GetPvzPvzIdReceptions
+func NewGetPvzPvzIdReceptionsRequest(server string, pvzId openapi_types.UUID, params *GetPvzPvzIdReceptionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/receptions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPvzPvzIdScheduleRequest generates requests for GetPvzPvzIdSchedule
+func NewGetPvzPvzIdScheduleRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutPvzPvzIdScheduleRequest calls the generic PutPvzPvzIdSchedule builder with application/json body
+func NewPutPvzPvzIdScheduleRequest(server string, pvzId openapi_types.UUID, body PutPvzPvzIdScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutPvzPvzIdScheduleRequestWithBody(server, pvzId, "application/json", bodyReader)
+}
+
+// NewPutPvzPvzIdScheduleRequestWithBody generates requests for PutPvzPvzIdSchedule with any type of body
+func NewPutPvzPvzIdScheduleRequestWithBody(server string, pvzId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePvzPvzIdScheduleExceptionsDateRequest generates requests for DeletePvzPvzIdScheduleExceptionsDate
+func NewDeletePvzPvzIdScheduleExceptionsDateRequest(server string, pvzId openapi_types.UUID, date openapi_types.Date) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/schedule/exceptions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutPvzPvzIdScheduleExceptionsDateRequest calls the generic PutPvzPvzIdScheduleExceptionsDate builder with application/json body
+func NewPutPvzPvzIdScheduleExceptionsDateRequest(server string, pvzId openapi_types.UUID, date openapi_types.Date, body PutPvzPvzIdScheduleExceptionsDateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutPvzPvzIdScheduleExceptionsDateRequestWithBody(server, pvzId, date, "application/json", bodyReader)
+}
+
+// NewPutPvzPvzIdScheduleExceptionsDateRequestWithBody generates requests for PutPvzPvzIdScheduleExceptionsDate with any type of body
+func NewPutPvzPvzIdScheduleExceptionsDateRequestWithBody(server string, pvzId openapi_types.UUID, date openapi_types.Date, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/schedule/exceptions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPvzPvzIdStaffRequest generates requests for GetPvzPvzIdStaff
+func NewGetPvzPvzIdStaffRequest(server string, pvzId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/staff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPvzPvzIdStaffRequest calls the generic PostPvzPvzIdStaff builder with application/json body
+func NewPostPvzPvzIdStaffRequest(server string, pvzId openapi_types.UUID, body PostPvzPvzIdStaffJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPvzPvzIdStaffRequestWithBody(server, pvzId, "application/json", bodyReader)
+}
+
+// NewPostPvzPvzIdStaffRequestWithBody generates requests for PostPvzPvzIdStaff with any type of body
+func NewPostPvzPvzIdStaffRequestWithBody(server string, pvzId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/staff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePvzPvzIdStaffUserIdRequest generates requests for DeletePvzPvzIdStaffUserId
+func NewDeletePvzPvzIdStaffUserIdRequest(server string, pvzId openapi_types.UUID, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "pvzId", runtime.ParamLocationPath, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pvz/%s/staff/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostReceptionsRequest calls the generic PostReceptions builder with application/json body
+func NewPostReceptionsRequest(server string, body PostReceptionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostReceptionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostReceptionsRequestWithBody generates requests for PostReceptions with any type of body
+func NewPostReceptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/receptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetReceptionsReceptionIdRequest generates requests for GetReceptionsReceptionId
+func NewGetReceptionsReceptionIdRequest(server string, receptionId openapi_types.UUID, params *GetReceptionsReceptionIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "receptionId", runtime.ParamLocationPath, receptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/receptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostRegisterRequest calls the generic PostRegister builder with application/json body
+func NewPostRegisterRequest(server string, body PostRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRegisterRequestWithBody generates requests for PostRegister with any type of body
+func NewPostRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTokenRefreshRequest calls the generic PostTokenRefresh builder with application/json body
+func NewPostTokenRefreshRequest(server string, body PostTokenRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTokenRefreshRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTokenRefreshRequestWithBody generates requests for PostTokenRefresh with any type of body
+func NewPostTokenRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/token/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersRequest generates requests for GetUsers
+func NewGetUsersRequest(server string, params *GetUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Role != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "role", runtime.ParamLocationQuery, *params.Role); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Disabled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "disabled", runtime.ParamLocationQuery, *params.Disabled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersUserIdRequest generates requests for DeleteUsersUserId
+func NewDeleteUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersUserIdRequest generates requests for GetUsersUserId
+func NewGetUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchUsersUserIdRequest calls the generic PatchUsersUserId builder with application/json body
+func NewPatchUsersUserIdRequest(server string, userId openapi_types.UUID, body PatchUsersUserIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchUsersUserIdRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewPatchUsersUserIdRequestWithBody generates requests for PatchUsersUserId with any type of body
+func NewPatchUsersUserIdRequestWithBody(server string, userId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetApiKeysWithResponse request
+	GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error)
+
+	// PostApiKeysWithBodyWithResponse request with any body
+	PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
+
+	PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error)
+
+	// DeleteApiKeysKeyIdWithResponse request
+	DeleteApiKeysKeyIdWithResponse(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiKeysKeyIdResponse, error)
+
+	// GetCitiesWithResponse request
+	GetCitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCitiesResponse, error)
+
+	// PostCitiesWithBodyWithResponse request with any body
+	PostCitiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCitiesResponse, error)
+
+	PostCitiesWithResponse(ctx context.Context, body PostCitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCitiesResponse, error)
+
+	// DeleteCitiesCityIdWithResponse request
+	DeleteCitiesCityIdWithResponse(ctx context.Context, cityId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCitiesCityIdResponse, error)
+
+	// PatchCitiesCityIdWithBodyWithResponse request with any body
+	PatchCitiesCityIdWithBodyWithResponse(ctx context.Context, cityId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCitiesCityIdResponse, error)
+
+	PatchCitiesCityIdWithResponse(ctx context.Context, cityId openapi_types.UUID, body PatchCitiesCityIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCitiesCityIdResponse, error)
+
 	// PostDummyLoginWithBodyWithResponse request with any body
 	PostDummyLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDummyLoginResponse, error)
 
-	PostDummyLoginWithResponse(ctx context.Context, body PostDummyLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDummyLoginResponse, error)
+	PostDummyLoginWithResponse(ctx context.Context, body PostDummyLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDummyLoginResponse, error)
+
+	// PostEmailVerifyWithBodyWithResponse request with any body
+	PostEmailVerifyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEmailVerifyResponse, error)
+
+	PostEmailVerifyWithResponse(ctx context.Context, body PostEmailVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEmailVerifyResponse, error)
+
+	// PostEmailVerifyResendWithBodyWithResponse request with any body
+	PostEmailVerifyResendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEmailVerifyResendResponse, error)
+
+	PostEmailVerifyResendWithResponse(ctx context.Context, body PostEmailVerifyResendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEmailVerifyResendResponse, error)
+
+	// GetInvitesWithResponse request
+	GetInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvitesResponse, error)
+
+	// PostInvitesWithBodyWithResponse request with any body
+	PostInvitesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInvitesResponse, error)
+
+	PostInvitesWithResponse(ctx context.Context, body PostInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInvitesResponse, error)
+
+	// DeleteInvitesInviteIdWithResponse request
+	DeleteInvitesInviteIdWithResponse(ctx context.Context, inviteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvitesInviteIdResponse, error)
+
+	// PostLoginWithBodyWithResponse request with any body
+	PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	// PostLogin2faWithBodyWithResponse request with any body
+	PostLogin2faWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogin2faResponse, error)
+
+	PostLogin2faWithResponse(ctx context.Context, body PostLogin2faJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogin2faResponse, error)
+
+	// PostLogin2faEnrollWithBodyWithResponse request with any body
+	PostLogin2faEnrollWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogin2faEnrollResponse, error)
+
+	PostLogin2faEnrollWithResponse(ctx context.Context, body PostLogin2faEnrollJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogin2faEnrollResponse, error)
+
+	// PostLogoutWithBodyWithResponse request with any body
+	PostLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error)
+
+	PostLogoutWithResponse(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error)
+
+	// PostMe2faConfirmWithBodyWithResponse request with any body
+	PostMe2faConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2faConfirmResponse, error)
+
+	PostMe2faConfirmWithResponse(ctx context.Context, body PostMe2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2faConfirmResponse, error)
+
+	// PostMe2faDisableWithBodyWithResponse request with any body
+	PostMe2faDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2faDisableResponse, error)
+
+	PostMe2faDisableWithResponse(ctx context.Context, body PostMe2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2faDisableResponse, error)
+
+	// PostMe2faEnrollWithResponse request
+	PostMe2faEnrollWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostMe2faEnrollResponse, error)
+
+	// PostMePasswordWithBodyWithResponse request with any body
+	PostMePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMePasswordResponse, error)
+
+	PostMePasswordWithResponse(ctx context.Context, body PostMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMePasswordResponse, error)
+
+	// PostPasswordResetWithBodyWithResponse request with any body
+	PostPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error)
+
+	PostPasswordResetWithResponse(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error)
+
+	// PostPasswordResetConfirmWithBodyWithResponse request with any body
+	PostPasswordResetConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error)
+
+	PostPasswordResetConfirmWithResponse(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error)
+
+	// GetProductTypesWithResponse request
+	GetProductTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProductTypesResponse, error)
+
+	// PostProductTypesWithBodyWithResponse request with any body
+	PostProductTypesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProductTypesResponse, error)
+
+	PostProductTypesWithResponse(ctx context.Context, body PostProductTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProductTypesResponse, error)
+
+	// DeleteProductTypesCodeWithResponse request
+	DeleteProductTypesCodeWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*DeleteProductTypesCodeResponse, error)
+
+	// PatchProductTypesCodeWithBodyWithResponse request with any body
+	PatchProductTypesCodeWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProductTypesCodeResponse, error)
+
+	PatchProductTypesCodeWithResponse(ctx context.Context, code string, body PatchProductTypesCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProductTypesCodeResponse, error)
+
+	// PostProductsWithBodyWithResponse request with any body
+	PostProductsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProductsResponse, error)
+
+	PostProductsWithResponse(ctx context.Context, body PostProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProductsResponse, error)
+
+	// GetPvzWithResponse request
+	GetPvzWithResponse(ctx context.Context, params *GetPvzParams, reqEditors ...RequestEditorFn) (*GetPvzResponse, error)
+
+	// PostPvzWithBodyWithResponse request with any body
+	PostPvzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPvzResponse, error)
+
+	PostPvzWithResponse(ctx context.Context, body PostPvzJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPvzResponse, error)
+
+	// GetPvzNearbyWithResponse request
+	GetPvzNearbyWithResponse(ctx context.Context, params *GetPvzNearbyParams, reqEditors ...RequestEditorFn) (*GetPvzNearbyResponse, error)
+
+	// DeletePvzPvzIdWithResponse request
+	DeletePvzPvzIdWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeletePvzPvzIdResponse, error)
+
+	// PatchPvzPvzIdWithBodyWithResponse request with any body
+	PatchPvzPvzIdWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchPvzPvzIdResponse, error)
+
+	PatchPvzPvzIdWithResponse(ctx context.Context, pvzId openapi_types.UUID, body PatchPvzPvzIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchPvzPvzIdResponse, error)
+
+	// PostPvzPvzIdCloseLastReceptionWithResponse request
+	PostPvzPvzIdCloseLastReceptionWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdCloseLastReceptionResponse, error)
+
+	// PostPvzPvzIdDeactivateWithResponse request
+	PostPvzPvzIdDeactivateWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdDeactivateResponse, error)
+
+	// PostPvzPvzIdDeleteLastProductWithResponse request
+	PostPvzPvzIdDeleteLastProductWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdDeleteLastProductResponse, error)
+
+	// GetPvzPvzIdReceptionsWithResponse request
+	GetPvzPvzIdReceptionsWithResponse(ctx context.Context, pvzId openapi_types.UUID, params *GetPvzPvzIdReceptionsParams, reqEditors ...RequestEditorFn) (*GetPvzPvzIdReceptionsResponse, error)
+
+	// GetPvzPvzIdScheduleWithResponse request
+	GetPvzPvzIdScheduleWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetPvzPvzIdScheduleResponse, error)
+
+	// PutPvzPvzIdScheduleWithBodyWithResponse request with any body
+	PutPvzPvzIdScheduleWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleResponse, error)
+
+	PutPvzPvzIdScheduleWithResponse(ctx context.Context, pvzId openapi_types.UUID, body PutPvzPvzIdScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleResponse, error)
+
+	// DeletePvzPvzIdScheduleExceptionsDateWithResponse request
+	DeletePvzPvzIdScheduleExceptionsDateWithResponse(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, reqEditors ...RequestEditorFn) (*DeletePvzPvzIdScheduleExceptionsDateResponse, error)
+
+	// PutPvzPvzIdScheduleExceptionsDateWithBodyWithResponse request with any body
+	PutPvzPvzIdScheduleExceptionsDateWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleExceptionsDateResponse, error)
+
+	PutPvzPvzIdScheduleExceptionsDateWithResponse(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, body PutPvzPvzIdScheduleExceptionsDateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleExceptionsDateResponse, error)
+
+	// GetPvzPvzIdStaffWithResponse request
+	GetPvzPvzIdStaffWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetPvzPvzIdStaffResponse, error)
+
+	// PostPvzPvzIdStaffWithBodyWithResponse request with any body
+	PostPvzPvzIdStaffWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPvzPvzIdStaffResponse, error)
+
+	PostPvzPvzIdStaffWithResponse(ctx context.Context, pvzId openapi_types.UUID, body PostPvzPvzIdStaffJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPvzPvzIdStaffResponse, error)
+
+	// DeletePvzPvzIdStaffUserIdWithResponse request
+	DeletePvzPvzIdStaffUserIdWithResponse(ctx context.Context, pvzId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeletePvzPvzIdStaffUserIdResponse, error)
+
+	// PostReceptionsWithBodyWithResponse request with any body
+	PostReceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error)
+
+	PostReceptionsWithResponse(ctx context.Context, body PostReceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error)
+
+	// GetReceptionsReceptionIdWithResponse request
+	GetReceptionsReceptionIdWithResponse(ctx context.Context, receptionId openapi_types.UUID, params *GetReceptionsReceptionIdParams, reqEditors ...RequestEditorFn) (*GetReceptionsReceptionIdResponse, error)
+
+	// PostRegisterWithBodyWithResponse request with any body
+	PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+
+	PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+
+	// PostTokenRefreshWithBodyWithResponse request with any body
+	PostTokenRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenRefreshResponse, error)
+
+	PostTokenRefreshWithResponse(ctx context.Context, body PostTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTokenRefreshResponse, error)
+
+	// GetUsersWithResponse request
+	GetUsersWithResponse(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+
+	// DeleteUsersUserIdWithResponse request
+	DeleteUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteUsersUserIdResponse, error)
+
+	// GetUsersUserIdWithResponse request
+	GetUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetUsersUserIdResponse, error)
+
+	// PatchUsersUserIdWithBodyWithResponse request with any body
+	PatchUsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsersUserIdResponse, error)
+
+	PatchUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PatchUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsersUserIdResponse, error)
+}
+
+type GetApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]APIKey
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedAPIKey
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiKeysKeyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiKeysKeyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiKeysKeyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]City
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *City
+	JSON400      *Error
+	JSON403      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCitiesCityIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCitiesCityIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCitiesCityIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchCitiesCityIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *City
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchCitiesCityIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchCitiesCityIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDummyLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Token
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDummyLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDummyLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEmailVerifyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEmailVerifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEmailVerifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostEmailVerifyResendResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON429      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostEmailVerifyResendResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostEmailVerifyResendResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Invite
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostInvitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedInvite
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostInvitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostInvitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInvitesInviteIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInvitesInviteIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInvitesInviteIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenPair
+	JSON202      *TwoFactorChallenge
+	JSON401      *Error
+	JSON403      *Error
+	JSON429      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLogin2faResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenPair
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON429      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLogin2faResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLogin2faResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLogin2faEnrollResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TwoFactorEnrollment
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLogin2faEnrollResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLogin2faEnrollResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMe2faConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMe2faConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMe2faConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMe2faDisableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMe2faDisableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMe2faDisableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMe2faEnrollResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TwoFactorEnrollment
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMe2faEnrollResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMe2faEnrollResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostMePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON429      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPasswordResetConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPasswordResetConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPasswordResetConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProductTypesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProductType
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProductTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProductTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProductTypesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProductType
+	JSON400      *Error
+	JSON403      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProductTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProductTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProductTypesCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProductTypesCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProductTypesCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchProductTypesCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProductType
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchProductTypesCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchProductTypesCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProductsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Product
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProductsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProductsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPvzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		Pvz        *PVZ `json:"pvz,omitempty"`
+		Receptions *[]struct {
+			Products  *[]Product `json:"products,omitempty"`
+			Reception *Reception `json:"reception,omitempty"`
+		} `json:"receptions,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPvzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPvzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPvzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PVZ
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPvzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPvzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPvzNearbyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]NearbyPVZ
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPvzNearbyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPvzNearbyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePvzPvzIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePvzPvzIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePvzPvzIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchPvzPvzIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PVZ
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchPvzPvzIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchPvzPvzIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPvzPvzIdCloseLastReceptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Reception
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPvzPvzIdCloseLastReceptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPvzPvzIdCloseLastReceptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPvzPvzIdDeactivateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PVZ
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPvzPvzIdDeactivateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPvzPvzIdDeactivateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPvzPvzIdDeleteLastProductResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPvzPvzIdDeleteLastProductResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPvzPvzIdDeleteLastProductResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPvzPvzIdReceptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ReceptionDetails
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPvzPvzIdReceptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPvzPvzIdReceptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPvzPvzIdScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PVZSchedule
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPvzPvzIdScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPvzPvzIdScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutPvzPvzIdScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PVZSchedule
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutPvzPvzIdScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutPvzPvzIdScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePvzPvzIdScheduleExceptionsDateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePvzPvzIdScheduleExceptionsDateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePvzPvzIdScheduleExceptionsDateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutPvzPvzIdScheduleExceptionsDateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScheduleException
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutPvzPvzIdScheduleExceptionsDateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutPvzPvzIdScheduleExceptionsDateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPvzPvzIdStaffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PVZStaff
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPvzPvzIdStaffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPvzPvzIdStaffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPvzPvzIdStaffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *[]PVZStaff
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPvzPvzIdStaffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPvzPvzIdStaffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePvzPvzIdStaffUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePvzPvzIdStaffUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePvzPvzIdStaffUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostReceptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Reception
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostReceptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostReceptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReceptionsReceptionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReceptionWithProducts
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReceptionsReceptionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReceptionsReceptionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTokenRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenPair
+	JSON401      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTokenRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTokenRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetApiKeysWithResponse request returning *GetApiKeysResponse
+func (c *ClientWithResponses) GetApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiKeysResponse, error) {
+	rsp, err := c.GetApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiKeysResponse(rsp)
+}
+
+// PostApiKeysWithBodyWithResponse request with arbitrary body returning *PostApiKeysResponse
+func (c *ClientWithResponses) PostApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
+	rsp, err := c.PostApiKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiKeysWithResponse(ctx context.Context, body PostApiKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiKeysResponse, error) {
+	rsp, err := c.PostApiKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiKeysResponse(rsp)
+}
+
+// DeleteApiKeysKeyIdWithResponse request returning *DeleteApiKeysKeyIdResponse
+func (c *ClientWithResponses) DeleteApiKeysKeyIdWithResponse(ctx context.Context, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiKeysKeyIdResponse, error) {
+	rsp, err := c.DeleteApiKeysKeyId(ctx, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiKeysKeyIdResponse(rsp)
+}
+
+// GetCitiesWithResponse request returning *GetCitiesResponse
+func (c *ClientWithResponses) GetCitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCitiesResponse, error) {
+	rsp, err := c.GetCities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCitiesResponse(rsp)
+}
+
+// PostCitiesWithBodyWithResponse request with arbitrary body returning *PostCitiesResponse
+func (c *ClientWithResponses) PostCitiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCitiesResponse, error) {
+	rsp, err := c.PostCitiesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCitiesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCitiesWithResponse(ctx context.Context, body PostCitiesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCitiesResponse, error) {
+	rsp, err := c.PostCities(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCitiesResponse(rsp)
+}
+
+// DeleteCitiesCityIdWithResponse request returning *DeleteCitiesCityIdResponse
+func (c *ClientWithResponses) DeleteCitiesCityIdWithResponse(ctx context.Context, cityId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCitiesCityIdResponse, error) {
+	rsp, err := c.DeleteCitiesCityId(ctx, cityId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCitiesCityIdResponse(rsp)
+}
+
+// PatchCitiesCityIdWithBodyWithResponse request with arbitrary body returning *PatchCitiesCityIdResponse
+func (c *ClientWithResponses) PatchCitiesCityIdWithBodyWithResponse(ctx context.Context, cityId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCitiesCityIdResponse, error) {
+	rsp, err := c.PatchCitiesCityIdWithBody(ctx, cityId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCitiesCityIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchCitiesCityIdWithResponse(ctx context.Context, cityId openapi_types.UUID, body PatchCitiesCityIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCitiesCityIdResponse, error) {
+	rsp, err := c.PatchCitiesCityId(ctx, cityId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCitiesCityIdResponse(rsp)
+}
+
+// PostDummyLoginWithBodyWithResponse request with arbitrary body returning *PostDummyLoginResponse
+func (c *ClientWithResponses) PostDummyLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDummyLoginResponse, error) {
+	rsp, err := c.PostDummyLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDummyLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDummyLoginWithResponse(ctx context.Context, body PostDummyLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDummyLoginResponse, error) {
+	rsp, err := c.PostDummyLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDummyLoginResponse(rsp)
+}
+
+// PostEmailVerifyWithBodyWithResponse request with arbitrary body returning *PostEmailVerifyResponse
+func (c *ClientWithResponses) PostEmailVerifyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEmailVerifyResponse, error) {
+	rsp, err := c.PostEmailVerifyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEmailVerifyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEmailVerifyWithResponse(ctx context.Context, body PostEmailVerifyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEmailVerifyResponse, error) {
+	rsp, err := c.PostEmailVerify(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEmailVerifyResponse(rsp)
+}
+
+// PostEmailVerifyResendWithBodyWithResponse request with arbitrary body returning *PostEmailVerifyResendResponse
+func (c *ClientWithResponses) PostEmailVerifyResendWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostEmailVerifyResendResponse, error) {
+	rsp, err := c.PostEmailVerifyResendWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEmailVerifyResendResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostEmailVerifyResendWithResponse(ctx context.Context, body PostEmailVerifyResendJSONRequestBody, reqEditors ...RequestEditorFn) (*PostEmailVerifyResendResponse, error) {
+	rsp, err := c.PostEmailVerifyResend(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostEmailVerifyResendResponse(rsp)
+}
+
+// GetInvitesWithResponse request returning *GetInvitesResponse
+func (c *ClientWithResponses) GetInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvitesResponse, error) {
+	rsp, err := c.GetInvites(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvitesResponse(rsp)
+}
+
+// PostInvitesWithBodyWithResponse request with arbitrary body returning *PostInvitesResponse
+func (c *ClientWithResponses) PostInvitesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostInvitesResponse, error) {
+	rsp, err := c.PostInvitesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInvitesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostInvitesWithResponse(ctx context.Context, body PostInvitesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostInvitesResponse, error) {
+	rsp, err := c.PostInvites(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostInvitesResponse(rsp)
+}
+
+// DeleteInvitesInviteIdWithResponse request returning *DeleteInvitesInviteIdResponse
+func (c *ClientWithResponses) DeleteInvitesInviteIdWithResponse(ctx context.Context, inviteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvitesInviteIdResponse, error) {
+	rsp, err := c.DeleteInvitesInviteId(ctx, inviteId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInvitesInviteIdResponse(rsp)
+}
+
+// PostLoginWithBodyWithResponse request with arbitrary body returning *PostLoginResponse
+func (c *ClientWithResponses) PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+// PostLogin2faWithBodyWithResponse request with arbitrary body returning *PostLogin2faResponse
+func (c *ClientWithResponses) PostLogin2faWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogin2faResponse, error) {
+	rsp, err := c.PostLogin2faWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogin2faResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLogin2faWithResponse(ctx context.Context, body PostLogin2faJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogin2faResponse, error) {
+	rsp, err := c.PostLogin2fa(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogin2faResponse(rsp)
+}
+
+// PostLogin2faEnrollWithBodyWithResponse request with arbitrary body returning *PostLogin2faEnrollResponse
+func (c *ClientWithResponses) PostLogin2faEnrollWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogin2faEnrollResponse, error) {
+	rsp, err := c.PostLogin2faEnrollWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogin2faEnrollResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLogin2faEnrollWithResponse(ctx context.Context, body PostLogin2faEnrollJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogin2faEnrollResponse, error) {
+	rsp, err := c.PostLogin2faEnroll(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogin2faEnrollResponse(rsp)
+}
+
+// PostLogoutWithBodyWithResponse request with arbitrary body returning *PostLogoutResponse
+func (c *ClientWithResponses) PostLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error) {
+	rsp, err := c.PostLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLogoutWithResponse(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error) {
+	rsp, err := c.PostLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogoutResponse(rsp)
+}
+
+// PostMe2faConfirmWithBodyWithResponse request with arbitrary body returning *PostMe2faConfirmResponse
+func (c *ClientWithResponses) PostMe2faConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2faConfirmResponse, error) {
+	rsp, err := c.PostMe2faConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2faConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMe2faConfirmWithResponse(ctx context.Context, body PostMe2faConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2faConfirmResponse, error) {
+	rsp, err := c.PostMe2faConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2faConfirmResponse(rsp)
+}
+
+// PostMe2faDisableWithBodyWithResponse request with arbitrary body returning *PostMe2faDisableResponse
+func (c *ClientWithResponses) PostMe2faDisableWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMe2faDisableResponse, error) {
+	rsp, err := c.PostMe2faDisableWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2faDisableResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMe2faDisableWithResponse(ctx context.Context, body PostMe2faDisableJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMe2faDisableResponse, error) {
+	rsp, err := c.PostMe2faDisable(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2faDisableResponse(rsp)
+}
+
+// PostMe2faEnrollWithResponse request returning *PostMe2faEnrollResponse
+func (c *ClientWithResponses) PostMe2faEnrollWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostMe2faEnrollResponse, error) {
+	rsp, err := c.PostMe2faEnroll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMe2faEnrollResponse(rsp)
+}
+
+// PostMePasswordWithBodyWithResponse request with arbitrary body returning *PostMePasswordResponse
+func (c *ClientWithResponses) PostMePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMePasswordResponse, error) {
+	rsp, err := c.PostMePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostMePasswordWithResponse(ctx context.Context, body PostMePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostMePasswordResponse, error) {
+	rsp, err := c.PostMePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMePasswordResponse(rsp)
+}
+
+// PostPasswordResetWithBodyWithResponse request with arbitrary body returning *PostPasswordResetResponse
+func (c *ClientWithResponses) PostPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error) {
+	rsp, err := c.PostPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPasswordResetWithResponse(ctx context.Context, body PostPasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error) {
+	rsp, err := c.PostPasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetResponse(rsp)
+}
+
+// PostPasswordResetConfirmWithBodyWithResponse request with arbitrary body returning *PostPasswordResetConfirmResponse
+func (c *ClientWithResponses) PostPasswordResetConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error) {
+	rsp, err := c.PostPasswordResetConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPasswordResetConfirmWithResponse(ctx context.Context, body PostPasswordResetConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetConfirmResponse, error) {
+	rsp, err := c.PostPasswordResetConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetConfirmResponse(rsp)
+}
+
+// GetProductTypesWithResponse request returning *GetProductTypesResponse
+func (c *ClientWithResponses) GetProductTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProductTypesResponse, error) {
+	rsp, err := c.GetProductTypes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProductTypesResponse(rsp)
+}
+
+// PostProductTypesWithBodyWithResponse request with arbitrary body returning *PostProductTypesResponse
+func (c *ClientWithResponses) PostProductTypesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProductTypesResponse, error) {
+	rsp, err := c.PostProductTypesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProductTypesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostProductTypesWithResponse(ctx context.Context, body PostProductTypesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProductTypesResponse, error) {
+	rsp, err := c.PostProductTypes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProductTypesResponse(rsp)
+}
+
+// DeleteProductTypesCodeWithResponse request returning *DeleteProductTypesCodeResponse
+func (c *ClientWithResponses) DeleteProductTypesCodeWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*DeleteProductTypesCodeResponse, error) {
+	rsp, err := c.DeleteProductTypesCode(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProductTypesCodeResponse(rsp)
+}
+
+// PatchProductTypesCodeWithBodyWithResponse request with arbitrary body returning *PatchProductTypesCodeResponse
+func (c *ClientWithResponses) PatchProductTypesCodeWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProductTypesCodeResponse, error) {
+	rsp, err := c.PatchProductTypesCodeWithBody(ctx, code, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProductTypesCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchProductTypesCodeWithResponse(ctx context.Context, code string, body PatchProductTypesCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProductTypesCodeResponse, error) {
+	rsp, err := c.PatchProductTypesCode(ctx, code, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProductTypesCodeResponse(rsp)
+}
+
+// PostProductsWithBodyWithResponse request with arbitrary body returning *PostProductsResponse
+func (c *ClientWithResponses) PostProductsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProductsResponse, error) {
+	rsp, err := c.PostProductsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProductsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostProductsWithResponse(ctx context.Context, body PostProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProductsResponse, error) {
+	rsp, err := c.PostProducts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProductsResponse(rsp)
+}
+
+// GetPvzWithResponse request returning *GetPvzResponse
+func (c *ClientWithResponses) GetPvzWithResponse(ctx context.Context, params *GetPvzParams, reqEditors ...RequestEditorFn) (*GetPvzResponse, error) {
+	rsp, err := c.GetPvz(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPvzResponse(rsp)
+}
+
+// PostPvzWithBodyWithResponse request with arbitrary body returning *PostPvzResponse
+func (c *ClientWithResponses) PostPvzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPvzResponse, error) {
+	rsp, err := c.PostPvzWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPvzResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPvzWithResponse(ctx context.Context, body PostPvzJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPvzResponse, error) {
+	rsp, err := c.PostPvz(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPvzResponse(rsp)
+}
+
+// GetPvzNearbyWithResponse request returning *GetPvzNearbyResponse
+func (c *ClientWithResponses) GetPvzNearbyWithResponse(ctx context.Context, params *GetPvzNearbyParams, reqEditors ...RequestEditorFn) (*GetPvzNearbyResponse, error) {
+	rsp, err := c.GetPvzNearby(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPvzNearbyResponse(rsp)
+}
+
+// DeletePvzPvzIdWithResponse request returning *DeletePvzPvzIdResponse
+func (c *ClientWithResponses) DeletePvzPvzIdWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeletePvzPvzIdResponse, error) {
+	rsp, err := c.DeletePvzPvzId(ctx, pvzId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePvzPvzIdResponse(rsp)
+}
+
+// PatchPvzPvzIdWithBodyWithResponse request with arbitrary body returning *PatchPvzPvzIdResponse
+func (c *ClientWithResponses) PatchPvzPvzIdWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchPvzPvzIdResponse, error) {
+	rsp, err := c.PatchPvzPvzIdWithBody(ctx, pvzId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchPvzPvzIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchPvzPvzIdWithResponse(ctx context.Context, pvzId openapi_types.UUID, body PatchPvzPvzIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchPvzPvzIdResponse, error) {
+	rsp, err := c.PatchPvzPvzId(ctx, pvzId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchPvzPvzIdResponse(rsp)
+}
+
+// PostPvzPvzIdCloseLastReceptionWithResponse request returning *PostPvzPvzIdCloseLastReceptionResponse
+func (c *ClientWithResponses) PostPvzPvzIdCloseLastReceptionWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdCloseLastReceptionResponse, error) {
+	rsp, err := c.PostPvzPvzIdCloseLastReception(ctx, pvzId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPvzPvzIdCloseLastReceptionResponse(rsp)
+}
+
+// PostPvzPvzIdDeactivateWithResponse request returning *PostPvzPvzIdDeactivateResponse
+func (c *ClientWithResponses) PostPvzPvzIdDeactivateWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdDeactivateResponse, error) {
+	rsp, err := c.PostPvzPvzIdDeactivate(ctx, pvzId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPvzPvzIdDeactivateResponse(rsp)
+}
+
+// PostPvzPvzIdDeleteLastProductWithResponse request returning *PostPvzPvzIdDeleteLastProductResponse
+func (c *ClientWithResponses) PostPvzPvzIdDeleteLastProductWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdDeleteLastProductResponse, error) {
+	rsp, err := c.PostPvzPvzIdDeleteLastProduct(ctx, pvzId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPvzPvzIdDeleteLastProductResponse(rsp)
+}
+
+// GetPvzPvzIdReceptionsWithResponse request returning *GetPvzPvzIdReceptionsResponse
+func (c *ClientWithResponses) GetPvzPvzIdReceptionsWithResponse(ctx context.Context, pvzId openapi_types.UUID, params *GetPvzPvzIdReceptionsParams, reqEditors ...RequestEditorFn) (*GetPvzPvzIdReceptionsResponse, error) {
+	rsp, err := c.GetPvzPvzIdReceptions(ctx, pvzId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPvzPvzIdReceptionsResponse(rsp)
+}
+
+// GetPvzPvzIdScheduleWithResponse request returning *GetPvzPvzIdScheduleResponse
+func (c *ClientWithResponses) GetPvzPvzIdScheduleWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetPvzPvzIdScheduleResponse, error) {
+	rsp, err := c.GetPvzPvzIdSchedule(ctx, pvzId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPvzPvzIdScheduleResponse(rsp)
+}
+
+// PutPvzPvzIdScheduleWithBodyWithResponse request with arbitrary body returning *PutPvzPvzIdScheduleResponse
+func (c *ClientWithResponses) PutPvzPvzIdScheduleWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleResponse, error) {
+	rsp, err := c.PutPvzPvzIdScheduleWithBody(ctx, pvzId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPvzPvzIdScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutPvzPvzIdScheduleWithResponse(ctx context.Context, pvzId openapi_types.UUID, body PutPvzPvzIdScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleResponse, error) {
+	rsp, err := c.PutPvzPvzIdSchedule(ctx, pvzId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPvzPvzIdScheduleResponse(rsp)
+}
+
+// DeletePvzPvzIdScheduleExceptionsDateWithResponse request returning *DeletePvzPvzIdScheduleExceptionsDateResponse
+func (c *ClientWithResponses) DeletePvzPvzIdScheduleExceptionsDateWithResponse(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, reqEditors ...RequestEditorFn) (*DeletePvzPvzIdScheduleExceptionsDateResponse, error) {
+	rsp, err := c.DeletePvzPvzIdScheduleExceptionsDate(ctx, pvzId, date, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePvzPvzIdScheduleExceptionsDateResponse(rsp)
+}
+
+// PutPvzPvzIdScheduleExceptionsDateWithBodyWithResponse request with arbitrary body returning *PutPvzPvzIdScheduleExceptionsDateResponse
+func (c *ClientWithResponses) PutPvzPvzIdScheduleExceptionsDateWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleExceptionsDateResponse, error) {
+	rsp, err := c.PutPvzPvzIdScheduleExceptionsDateWithBody(ctx, pvzId, date, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPvzPvzIdScheduleExceptionsDateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutPvzPvzIdScheduleExceptionsDateWithResponse(ctx context.Context, pvzId openapi_types.UUID, date openapi_types.Date, body PutPvzPvzIdScheduleExceptionsDateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutPvzPvzIdScheduleExceptionsDateResponse, error) {
+	rsp, err := c.PutPvzPvzIdScheduleExceptionsDate(ctx, pvzId, date, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutPvzPvzIdScheduleExceptionsDateResponse(rsp)
+}
+
+// GetPvzPvzIdStaffWithResponse request returning *GetPvzPvzIdStaffResponse
+func (c *ClientWithResponses) GetPvzPvzIdStaffWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetPvzPvzIdStaffResponse, error) {
+	rsp, err := c.GetPvzPvzIdStaff(ctx, pvzId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPvzPvzIdStaffResponse(rsp)
+}
+
+// PostPvzPvzIdStaffWithBodyWithResponse request with arbitrary body returning *PostPvzPvzIdStaffResponse
+func (c *ClientWithResponses) PostPvzPvzIdStaffWithBodyWithResponse(ctx context.Context, pvzId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPvzPvzIdStaffResponse, error) {
+	rsp, err := c.PostPvzPvzIdStaffWithBody(ctx, pvzId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPvzPvzIdStaffResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPvzPvzIdStaffWithResponse(ctx context.Context, pvzId openapi_types.UUID, body PostPvzPvzIdStaffJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPvzPvzIdStaffResponse, error) {
+	rsp, err := c.PostPvzPvzIdStaff(ctx, pvzId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPvzPvzIdStaffResponse(rsp)
+}
+
+// DeletePvzPvzIdStaffUserIdWithResponse request returning *DeletePvzPvzIdStaffUserIdResponse
+func (c *ClientWithResponses) DeletePvzPvzIdStaffUserIdWithResponse(ctx context.Context, pvzId openapi_types.UUID, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeletePvzPvzIdStaffUserIdResponse, error) {
+	rsp, err := c.DeletePvzPvzIdStaffUserId(ctx, pvzId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePvzPvzIdStaffUserIdResponse(rsp)
+}
+
+// PostReceptionsWithBodyWithResponse request with arbitrary body returning *PostReceptionsResponse
+func (c *ClientWithResponses) PostReceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error) {
+	rsp, err := c.PostReceptionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostReceptionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostReceptionsWithResponse(ctx context.Context, body PostReceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error) {
+	rsp, err := c.PostReceptions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostReceptionsResponse(rsp)
+}
+
+// GetReceptionsReceptionIdWithResponse request returning *GetReceptionsReceptionIdResponse
+func (c *ClientWithResponses) GetReceptionsReceptionIdWithResponse(ctx context.Context, receptionId openapi_types.UUID, params *GetReceptionsReceptionIdParams, reqEditors ...RequestEditorFn) (*GetReceptionsReceptionIdResponse, error) {
+	rsp, err := c.GetReceptionsReceptionId(ctx, receptionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReceptionsReceptionIdResponse(rsp)
+}
+
+// PostRegisterWithBodyWithResponse request with arbitrary body returning *PostRegisterResponse
+func (c *ClientWithResponses) PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
+	rsp, err := c.PostRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
+	rsp, err := c.PostRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRegisterResponse(rsp)
+}
+
+// PostTokenRefreshWithBodyWithResponse request with arbitrary body returning *PostTokenRefreshResponse
+func (c *ClientWithResponses) PostTokenRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenRefreshResponse, error) {
+	rsp, err := c.PostTokenRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTokenRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTokenRefreshWithResponse(ctx context.Context, body PostTokenRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTokenRefreshResponse, error) {
+	rsp, err := c.PostTokenRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTokenRefreshResponse(rsp)
+}
+
+// GetUsersWithResponse request returning *GetUsersResponse
+func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, params *GetUsersParams, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
+	rsp, err := c.GetUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersResponse(rsp)
+}
+
+// DeleteUsersUserIdWithResponse request returning *DeleteUsersUserIdResponse
+func (c *ClientWithResponses) DeleteUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteUsersUserIdResponse, error) {
+	rsp, err := c.DeleteUsersUserId(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersUserIdResponse(rsp)
+}
+
+// GetUsersUserIdWithResponse request returning *GetUsersUserIdResponse
+func (c *ClientWithResponses) GetUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetUsersUserIdResponse, error) {
+	rsp, err := c.GetUsersUserId(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersUserIdResponse(rsp)
+}
+
+// PatchUsersUserIdWithBodyWithResponse request with arbitrary body returning *PatchUsersUserIdResponse
+func (c *ClientWithResponses) PatchUsersUserIdWithBodyWithResponse(ctx context.Context, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchUsersUserIdResponse, error) {
+	rsp, err := c.PatchUsersUserIdWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsersUserIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, body PatchUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchUsersUserIdResponse, error) {
+	rsp, err := c.PatchUsersUserId(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchUsersUserIdResponse(rsp)
+}
+
+// ParseGetApiKeysResponse parses an HTTP response from a GetApiKeysWithResponse call
+func ParseGetApiKeysResponse(rsp *http.Response) (*GetApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []APIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiKeysResponse parses an HTTP response from a PostApiKeysWithResponse call
+func ParsePostApiKeysResponse(rsp *http.Response) (*PostApiKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedAPIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiKeysKeyIdResponse parses an HTTP response from a DeleteApiKeysKeyIdWithResponse call
+func ParseDeleteApiKeysKeyIdResponse(rsp *http.Response) (*DeleteApiKeysKeyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiKeysKeyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCitiesResponse parses an HTTP response from a GetCitiesWithResponse call
+func ParseGetCitiesResponse(rsp *http.Response) (*GetCitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []City
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCitiesResponse parses an HTTP response from a PostCitiesWithResponse call
+func ParsePostCitiesResponse(rsp *http.Response) (*PostCitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest City
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCitiesCityIdResponse parses an HTTP response from a DeleteCitiesCityIdWithResponse call
+func ParseDeleteCitiesCityIdResponse(rsp *http.Response) (*DeleteCitiesCityIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCitiesCityIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchCitiesCityIdResponse parses an HTTP response from a PatchCitiesCityIdWithResponse call
+func ParsePatchCitiesCityIdResponse(rsp *http.Response) (*PatchCitiesCityIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchCitiesCityIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest City
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDummyLoginResponse parses an HTTP response from a PostDummyLoginWithResponse call
+func ParsePostDummyLoginResponse(rsp *http.Response) (*PostDummyLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDummyLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Token
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEmailVerifyResponse parses an HTTP response from a PostEmailVerifyWithResponse call
+func ParsePostEmailVerifyResponse(rsp *http.Response) (*PostEmailVerifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEmailVerifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostEmailVerifyResendResponse parses an HTTP response from a PostEmailVerifyResendWithResponse call
+func ParsePostEmailVerifyResendResponse(rsp *http.Response) (*PostEmailVerifyResendResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostEmailVerifyResendResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInvitesResponse parses an HTTP response from a GetInvitesWithResponse call
+func ParseGetInvitesResponse(rsp *http.Response) (*GetInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInvitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Invite
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostInvitesResponse parses an HTTP response from a PostInvitesWithResponse call
+func ParsePostInvitesResponse(rsp *http.Response) (*PostInvitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostInvitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedInvite
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInvitesInviteIdResponse parses an HTTP response from a DeleteInvitesInviteIdWithResponse call
+func ParseDeleteInvitesInviteIdResponse(rsp *http.Response) (*DeleteInvitesInviteIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInvitesInviteIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginResponse parses an HTTP response from a PostLoginWithResponse call
+func ParsePostLoginResponse(rsp *http.Response) (*PostLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenPair
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest TwoFactorChallenge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLogin2faResponse parses an HTTP response from a PostLogin2faWithResponse call
+func ParsePostLogin2faResponse(rsp *http.Response) (*PostLogin2faResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLogin2faResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenPair
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLogin2faEnrollResponse parses an HTTP response from a PostLogin2faEnrollWithResponse call
+func ParsePostLogin2faEnrollResponse(rsp *http.Response) (*PostLogin2faEnrollResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLogin2faEnrollResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLogoutResponse parses an HTTP response from a PostLogoutWithResponse call
+func ParsePostLogoutResponse(rsp *http.Response) (*PostLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMe2faConfirmResponse parses an HTTP response from a PostMe2faConfirmWithResponse call
+func ParsePostMe2faConfirmResponse(rsp *http.Response) (*PostMe2faConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMe2faConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMe2faDisableResponse parses an HTTP response from a PostMe2faDisableWithResponse call
+func ParsePostMe2faDisableResponse(rsp *http.Response) (*PostMe2faDisableResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMe2faDisableResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMe2faEnrollResponse parses an HTTP response from a PostMe2faEnrollWithResponse call
+func ParsePostMe2faEnrollResponse(rsp *http.Response) (*PostMe2faEnrollResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMe2faEnrollResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TwoFactorEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostMePasswordResponse parses an HTTP response from a PostMePasswordWithResponse call
+func ParsePostMePasswordResponse(rsp *http.Response) (*PostMePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostMePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPasswordResetResponse parses an HTTP response from a PostPasswordResetWithResponse call
+func ParsePostPasswordResetResponse(rsp *http.Response) (*PostPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPasswordResetConfirmResponse parses an HTTP response from a PostPasswordResetConfirmWithResponse call
+func ParsePostPasswordResetConfirmResponse(rsp *http.Response) (*PostPasswordResetConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPasswordResetConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProductTypesResponse parses an HTTP response from a GetProductTypesWithResponse call
+func ParseGetProductTypesResponse(rsp *http.Response) (*GetProductTypesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProductTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProductType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostProductTypesResponse parses an HTTP response from a PostProductTypesWithResponse call
+func ParsePostProductTypesResponse(rsp *http.Response) (*PostProductTypesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProductTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProductType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProductTypesCodeResponse parses an HTTP response from a DeleteProductTypesCodeWithResponse call
+func ParseDeleteProductTypesCodeResponse(rsp *http.Response) (*DeleteProductTypesCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProductTypesCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchProductTypesCodeResponse parses an HTTP response from a PatchProductTypesCodeWithResponse call
+func ParsePatchProductTypesCodeResponse(rsp *http.Response) (*PatchProductTypesCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchProductTypesCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProductType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostProductsResponse parses an HTTP response from a PostProductsWithResponse call
+func ParsePostProductsResponse(rsp *http.Response) (*PostProductsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProductsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Product
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPvzResponse parses an HTTP response from a GetPvzWithResponse call
+func ParseGetPvzResponse(rsp *http.Response) (*GetPvzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPvzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			Pvz        *PVZ `json:"pvz,omitempty"`
+			Receptions *[]struct {
+				Products  *[]Product `json:"products,omitempty"`
+				Reception *Reception `json:"reception,omitempty"`
+			} `json:"receptions,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPvzResponse parses an HTTP response from a PostPvzWithResponse call
+func ParsePostPvzResponse(rsp *http.Response) (*PostPvzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPvzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PVZ
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPvzNearbyResponse parses an HTTP response from a GetPvzNearbyWithResponse call
+func ParseGetPvzNearbyResponse(rsp *http.Response) (*GetPvzNearbyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPvzNearbyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []NearbyPVZ
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePvzPvzIdResponse parses an HTTP response from a DeletePvzPvzIdWithResponse call
+func ParseDeletePvzPvzIdResponse(rsp *http.Response) (*DeletePvzPvzIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePvzPvzIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchPvzPvzIdResponse parses an HTTP response from a PatchPvzPvzIdWithResponse call
+func ParsePatchPvzPvzIdResponse(rsp *http.Response) (*PatchPvzPvzIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchPvzPvzIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PVZ
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPvzPvzIdCloseLastReceptionResponse parses an HTTP response from a PostPvzPvzIdCloseLastReceptionWithResponse call
+func ParsePostPvzPvzIdCloseLastReceptionResponse(rsp *http.Response) (*PostPvzPvzIdCloseLastReceptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPvzPvzIdCloseLastReceptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Reception
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPvzPvzIdDeactivateResponse parses an HTTP response from a PostPvzPvzIdDeactivateWithResponse call
+func ParsePostPvzPvzIdDeactivateResponse(rsp *http.Response) (*PostPvzPvzIdDeactivateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPvzPvzIdDeactivateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PVZ
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPvzPvzIdDeleteLastProductResponse parses an HTTP response from a PostPvzPvzIdDeleteLastProductWithResponse call
+func ParsePostPvzPvzIdDeleteLastProductResponse(rsp *http.Response) (*PostPvzPvzIdDeleteLastProductResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPvzPvzIdDeleteLastProductResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPvzPvzIdReceptionsResponse parses an HTTP response from a GetPvzPvzIdReceptionsWithResponse call
+func ParseGetPvzPvzIdReceptionsResponse(rsp *http.Response) (*GetPvzPvzIdReceptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPvzPvzIdReceptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ReceptionDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPvzPvzIdScheduleResponse parses an HTTP response from a GetPvzPvzIdScheduleWithResponse call
+func ParseGetPvzPvzIdScheduleResponse(rsp *http.Response) (*GetPvzPvzIdScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPvzPvzIdScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PVZSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutPvzPvzIdScheduleResponse parses an HTTP response from a PutPvzPvzIdScheduleWithResponse call
+func ParsePutPvzPvzIdScheduleResponse(rsp *http.Response) (*PutPvzPvzIdScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutPvzPvzIdScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PVZSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePvzPvzIdScheduleExceptionsDateResponse parses an HTTP response from a DeletePvzPvzIdScheduleExceptionsDateWithResponse call
+func ParseDeletePvzPvzIdScheduleExceptionsDateResponse(rsp *http.Response) (*DeletePvzPvzIdScheduleExceptionsDateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePvzPvzIdScheduleExceptionsDateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutPvzPvzIdScheduleExceptionsDateResponse parses an HTTP response from a PutPvzPvzIdScheduleExceptionsDateWithResponse call
+func ParsePutPvzPvzIdScheduleExceptionsDateResponse(rsp *http.Response) (*PutPvzPvzIdScheduleExceptionsDateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutPvzPvzIdScheduleExceptionsDateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduleException
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPvzPvzIdStaffResponse parses an HTTP response from a GetPvzPvzIdStaffWithResponse call
+func ParseGetPvzPvzIdStaffResponse(rsp *http.Response) (*GetPvzPvzIdStaffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPvzPvzIdStaffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PVZStaff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPvzPvzIdStaffResponse parses an HTTP response from a PostPvzPvzIdStaffWithResponse call
+func ParsePostPvzPvzIdStaffResponse(rsp *http.Response) (*PostPvzPvzIdStaffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPvzPvzIdStaffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest []PVZStaff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePvzPvzIdStaffUserIdResponse parses an HTTP response from a DeletePvzPvzIdStaffUserIdWithResponse call
+func ParseDeletePvzPvzIdStaffUserIdResponse(rsp *http.Response) (*DeletePvzPvzIdStaffUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePvzPvzIdStaffUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostReceptionsResponse parses an HTTP response from a PostReceptionsWithResponse call
+func ParsePostReceptionsResponse(rsp *http.Response) (*PostReceptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostReceptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Reception
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReceptionsReceptionIdResponse parses an HTTP response from a GetReceptionsReceptionIdWithResponse call
+func ParseGetReceptionsReceptionIdResponse(rsp *http.Response) (*GetReceptionsReceptionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReceptionsReceptionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReceptionWithProducts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRegisterResponse parses an HTTP response from a PostRegisterWithResponse call
+func ParsePostRegisterResponse(rsp *http.Response) (*PostRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTokenRefreshResponse parses an HTTP response from a PostTokenRefreshWithResponse call
+func ParsePostTokenRefreshResponse(rsp *http.Response) (*PostTokenRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTokenRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenPair
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
+func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersUserIdResponse parses an HTTP response from a DeleteUsersUserIdWithResponse call
+func ParseDeleteUsersUserIdResponse(rsp *http.Response) (*DeleteUsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersUserIdResponse parses an HTTP response from a GetUsersUserIdWithResponse call
+func ParseGetUsersUserIdResponse(rsp *http.Response) (*GetUsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchUsersUserIdResponse parses an HTTP response from a PatchUsersUserIdWithResponse call
+func ParsePatchUsersUserIdResponse(rsp *http.Response) (*PatchUsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchUsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Список API ключей интеграций (только для модераторов)
+	// (GET /api-keys)
+	GetApiKeys(c *gin.Context)
+	// Создание API ключа интеграции (только для модераторов)
+	// (POST /api-keys)
+	PostApiKeys(c *gin.Context)
+	// Отзыв API ключа (только для модераторов)
+	// (DELETE /api-keys/{keyId})
+	DeleteApiKeysKeyId(c *gin.Context, keyId openapi_types.UUID)
+	// Получение справочника городов
+	// (GET /cities)
+	GetCities(c *gin.Context)
+	// Добавление города (только для модераторов)
+	// (POST /cities)
+	PostCities(c *gin.Context)
+	// Удаление города без ПВЗ (только для модераторов)
+	// (DELETE /cities/{cityId})
+	DeleteCitiesCityId(c *gin.Context, cityId openapi_types.UUID)
+	// Активация, деактивация и изменение даты запуска города (только для модераторов)
+	// (PATCH /cities/{cityId})
+	PatchCitiesCityId(c *gin.Context, cityId openapi_types.UUID)
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	PostDummyLogin(c *gin.Context)
+	// Подтверждение почты по токену из письма
+	// (POST /email/verify)
+	PostEmailVerify(c *gin.Context)
+	// Повторная отправка письма для подтверждения почты
+	// (POST /email/verify/resend)
+	PostEmailVerifyResend(c *gin.Context)
+	// Список приглашений (только для модераторов)
+	// (GET /invites)
+	GetInvites(c *gin.Context)
+	// Создание приглашения с фиксированной ролью (только для модераторов)
+	// (POST /invites)
+	PostInvites(c *gin.Context)
+	// Отзыв приглашения (только для модераторов)
+	// (DELETE /invites/{inviteId})
+	DeleteInvitesInviteId(c *gin.Context, inviteId openapi_types.UUID)
+	// Авторизация пользователя
+	// (POST /login)
+	PostLogin(c *gin.Context)
+	// Завершение входа кодом TOTP или кодом восстановления
+	// (POST /login/2fa)
+	PostLogin2fa(c *gin.Context)
+	// Настройка обязательной 2FA во время входа
+	// (POST /login/2fa/enroll)
+	PostLogin2faEnroll(c *gin.Context)
+	// Выход из системы (отзыв текущего access токена и refresh токена)
+	// (POST /logout)
+	PostLogout(c *gin.Context)
+	// Включение 2FA кодом из приложения-аутентификатора
+	// (POST /me/2fa/confirm)
+	PostMe2faConfirm(c *gin.Context)
+	// Отключение 2FA кодом TOTP или кодом восстановления
+	// (POST /me/2fa/disable)
+	PostMe2faDisable(c *gin.Context)
+	// Подключение 2FA текущим пользователем
+	// (POST /me/2fa/enroll)
+	PostMe2faEnroll(c *gin.Context)
+	// Смена пароля текущего пользователя
+	// (POST /me/password)
+	PostMePassword(c *gin.Context)
+	// Запрос письма для сброса пароля
+	// (POST /password/reset)
+	PostPasswordReset(c *gin.Context)
+	// Установка нового пароля по токену из письма
+	// (POST /password/reset/confirm)
+	PostPasswordResetConfirm(c *gin.Context)
+	// Получение справочника типов товаров
+	// (GET /product-types)
+	GetProductTypes(c *gin.Context)
+	// Добавление типа товара (только для модераторов)
+	// (POST /product-types)
+	PostProductTypes(c *gin.Context)
+	// Удаление неиспользуемого типа товара (только для модераторов)
+	// (DELETE /product-types/{code})
+	DeleteProductTypesCode(c *gin.Context, code string)
+	// Изменение типа товара (только для модераторов)
+	// (PATCH /product-types/{code})
+	PatchProductTypesCode(c *gin.Context, code string)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	PostProducts(c *gin.Context)
+	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
+	// (GET /pvz)
+	GetPvz(c *gin.Context, params GetPvzParams)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	PostPvz(c *gin.Context)
+	// Поиск ближайших ПВЗ по координатам, отсортированных по расстоянию
+	// (GET /pvz/nearby)
+	GetPvzNearby(c *gin.Context, params GetPvzNearbyParams)
+	// Удаление ПВЗ без приемок (только для модераторов)
+	// (DELETE /pvz/{pvzId})
+	DeletePvzPvzId(c *gin.Context, pvzId openapi_types.UUID)
+	// Изменение города, статуса или адреса ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(c *gin.Context, pvzId openapi_types.UUID)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId openapi_types.UUID)
+	// Закрытие ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/deactivate)
+	PostPvzPvzIdDeactivate(c *gin.Context, pvzId openapi_types.UUID)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId openapi_types.UUID)
+	// История приемок ПВЗ, сначала новые
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(c *gin.Context, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams)
+	// Получение графика работы ПВЗ
+	// (GET /pvz/{pvzId}/schedule)
+	GetPvzPvzIdSchedule(c *gin.Context, pvzId openapi_types.UUID)
+	// Замена недельного графика работы ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId}/schedule)
+	PutPvzPvzIdSchedule(c *gin.Context, pvzId openapi_types.UUID)
+	// Удаление исключения в графике работы (только для модераторов)
+	// (DELETE /pvz/{pvzId}/schedule/exceptions/{date})
+	DeletePvzPvzIdScheduleExceptionsDate(c *gin.Context, pvzId openapi_types.UUID, date openapi_types.Date)
+	// Исключение в графике работы на дату — выходной или особые часы (только для модераторов)
+	// (PUT /pvz/{pvzId}/schedule/exceptions/{date})
+	PutPvzPvzIdScheduleExceptionsDate(c *gin.Context, pvzId openapi_types.UUID, date openapi_types.Date)
+	// Сотрудники, закреплённые за ПВЗ (только для модераторов)
+	// (GET /pvz/{pvzId}/staff)
+	GetPvzPvzIdStaff(c *gin.Context, pvzId openapi_types.UUID)
+	// Закрепление сотрудника за ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/staff)
+	PostPvzPvzIdStaff(c *gin.Context, pvzId openapi_types.UUID)
+	// Снятие сотрудника с ПВЗ (только для модераторов)
+	// (DELETE /pvz/{pvzId}/staff/{userId})
+	DeletePvzPvzIdStaffUserId(c *gin.Context, pvzId openapi_types.UUID, userId openapi_types.UUID)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	PostReceptions(c *gin.Context)
+	// Получение приемки с товарами, товары возвращаются постранично в порядке добавления
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(c *gin.Context, receptionId openapi_types.UUID, params GetReceptionsReceptionIdParams)
+	// Регистрация пользователя
+	// (POST /register)
+	PostRegister(c *gin.Context)
+	// Обновление пары токенов по refresh токену
+	// (POST /token/refresh)
+	PostTokenRefresh(c *gin.Context)
+	// Список пользователей (только для модераторов)
+	// (GET /users)
+	GetUsers(c *gin.Context, params GetUsersParams)
+	// Удаление пользователя (только для модераторов)
+	// (DELETE /users/{userId})
+	DeleteUsersUserId(c *gin.Context, userId openapi_types.UUID)
+	// Получение пользователя (только для модераторов)
+	// (GET /users/{userId})
+	GetUsersUserId(c *gin.Context, userId openapi_types.UUID)
+	// Изменение роли и отключение пользователя (только для модераторов)
+	// (PATCH /users/{userId})
+	PatchUsersUserId(c *gin.Context, userId openapi_types.UUID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiKeys(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiKeys(c)
+}
+
+// PostApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostApiKeys(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiKeys(c)
+}
+
+// DeleteApiKeysKeyId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiKeysKeyId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", c.Param("keyId"), &keyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keyId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiKeysKeyId(c, keyId)
+}
+
+// GetCities operation middleware
+func (siw *ServerInterfaceWrapper) GetCities(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCities(c)
+}
+
+// PostCities operation middleware
+func (siw *ServerInterfaceWrapper) PostCities(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCities(c)
+}
+
+// DeleteCitiesCityId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCitiesCityId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "cityId" -------------
+	var cityId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cityId", c.Param("cityId"), &cityId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cityId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteCitiesCityId(c, cityId)
+}
+
+// PatchCitiesCityId operation middleware
+func (siw *ServerInterfaceWrapper) PatchCitiesCityId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "cityId" -------------
+	var cityId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cityId", c.Param("cityId"), &cityId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cityId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchCitiesCityId(c, cityId)
+}
+
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostDummyLogin(c)
+}
+
+// PostEmailVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostEmailVerify(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostEmailVerify(c)
+}
+
+// PostEmailVerifyResend operation middleware
+func (siw *ServerInterfaceWrapper) PostEmailVerifyResend(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostEmailVerifyResend(c)
+}
+
+// GetInvites operation middleware
+func (siw *ServerInterfaceWrapper) GetInvites(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetInvites(c)
+}
+
+// PostInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostInvites(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostInvites(c)
+}
+
+// DeleteInvitesInviteId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInvitesInviteId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "inviteId" -------------
+	var inviteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inviteId", c.Param("inviteId"), &inviteId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter inviteId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteInvitesInviteId(c, inviteId)
+}
+
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogin(c)
+}
+
+// PostLogin2fa operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin2fa(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogin2fa(c)
+}
+
+// PostLogin2faEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin2faEnroll(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogin2faEnroll(c)
+}
+
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogout(c)
+}
+
+// PostMe2faConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostMe2faConfirm(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMe2faConfirm(c)
+}
+
+// PostMe2faDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostMe2faDisable(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMe2faDisable(c)
+}
+
+// PostMe2faEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostMe2faEnroll(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMe2faEnroll(c)
+}
+
+// PostMePassword operation middleware
+func (siw *ServerInterfaceWrapper) PostMePassword(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMePassword(c)
+}
+
+// PostPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordReset(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPasswordReset(c)
+}
+
+// PostPasswordResetConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordResetConfirm(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPasswordResetConfirm(c)
+}
+
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
 
-	// PostLoginWithBodyWithResponse request with any body
-	PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+	siw.Handler.GetProductTypes(c)
+}
 
-	// PostProductsWithBodyWithResponse request with any body
-	PostProductsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProductsResponse, error)
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(c *gin.Context) {
 
-	PostProductsWithResponse(ctx context.Context, body PostProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProductsResponse, error)
+	c.Set(BearerAuthScopes, []string{})
 
-	// GetPvzWithResponse request
-	GetPvzWithResponse(ctx context.Context, params *GetPvzParams, reqEditors ...RequestEditorFn) (*GetPvzResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// PostPvzWithBodyWithResponse request with any body
-	PostPvzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPvzResponse, error)
+	siw.Handler.PostProductTypes(c)
+}
 
-	PostPvzWithResponse(ctx context.Context, body PostPvzJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPvzResponse, error)
+// DeleteProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductTypesCode(c *gin.Context) {
 
-	// PostPvzPvzIdCloseLastReceptionWithResponse request
-	PostPvzPvzIdCloseLastReceptionWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdCloseLastReceptionResponse, error)
+	var err error
 
-	// PostPvzPvzIdDeleteLastProductWithResponse request
-	PostPvzPvzIdDeleteLastProductWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdDeleteLastProductResponse, error)
+	// ------------- Path parameter "code" -------------
+	var code string
 
-	// PostReceptionsWithBodyWithResponse request with any body
-	PostReceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error)
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	PostReceptionsWithResponse(ctx context.Context, body PostReceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error)
+	c.Set(BearerAuthScopes, []string{})
 
-	// PostRegisterWithBodyWithResponse request with any body
-	PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+	siw.Handler.DeleteProductTypesCode(c, code)
 }
 
-type PostDummyLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Token
-	JSON400      *Error
-}
+// PatchProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) PatchProductTypesCode(c *gin.Context) {
 
-// Status returns HTTPResponse.Status
-func (r PostDummyLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostDummyLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type PostLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Token
-	JSON401      *Error
+	siw.Handler.PatchProductTypesCode(c, code)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return http.StatusText(0)
+
+	siw.Handler.PostProducts(c)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
 	}
-	return 0
-}
 
-type PostProductsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Product
-	JSON400      *Error
-	JSON403      *Error
-}
+	// ------------- Optional query parameter "endDate" -------------
 
-// Status returns HTTPResponse.Status
-func (r PostProductsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostProductsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
 	}
-	return 0
-}
 
-type GetPvzResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]struct {
-		Pvz        *PVZ `json:"pvz,omitempty"`
-		Receptions *[]struct {
-			Products  *[]Product `json:"products,omitempty"`
-			Reception *Reception `json:"reception,omitempty"`
-		} `json:"receptions,omitempty"`
+	// ------------- Optional query parameter "pvzStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzStatus", c.Request.URL.Query(), &params.PvzStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzStatus: %w", err), http.StatusBadRequest)
+		return
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetPvzResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPvzResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "productType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "productType", c.Request.URL.Query(), &params.ProductType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productType: %w", err), http.StatusBadRequest)
+		return
 	}
-	return 0
-}
 
-type PostPvzResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *PVZ
-	JSON400      *Error
-	JSON403      *Error
-}
+	// ------------- Optional query parameter "page" -------------
 
-// Status returns HTTPResponse.Status
-func (r PostPvzResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPvzResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
-	return 0
-}
 
-type PostPvzPvzIdCloseLastReceptionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Reception
-	JSON400      *Error
-	JSON403      *Error
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// Status returns HTTPResponse.Status
-func (r PostPvzPvzIdCloseLastReceptionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPvzPvzIdCloseLastReceptionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
-}
 
-type PostPvzPvzIdDeleteLastProductResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON403      *Error
+	siw.Handler.GetPvz(c, params)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostPvzPvzIdDeleteLastProductResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostPvzPvzIdDeleteLastProductResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	siw.Handler.PostPvz(c)
 }
 
-type PostReceptionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Reception
-	JSON400      *Error
-	JSON403      *Error
-}
+// GetPvzNearby operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzNearby(c *gin.Context) {
 
-// Status returns HTTPResponse.Status
-func (r PostReceptionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzNearbyParams
+
+	// ------------- Required query parameter "lat" -------------
+
+	if paramValue := c.Query("lat"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument lat is required, but not found"), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostReceptionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindQueryParameter("form", true, true, "lat", c.Request.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lat: %w", err), http.StatusBadRequest)
+		return
 	}
-	return 0
-}
 
-type PostRegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *User
-	JSON400      *Error
-}
+	// ------------- Required query parameter "lon" -------------
 
-// Status returns HTTPResponse.Status
-func (r PostRegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if paramValue := c.Query("lon"); paramValue != "" {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostRegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument lon is required, but not found"), http.StatusBadRequest)
+		return
 	}
-	return 0
-}
 
-// PostDummyLoginWithBodyWithResponse request with arbitrary body returning *PostDummyLoginResponse
-func (c *ClientWithResponses) PostDummyLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDummyLoginResponse, error) {
-	rsp, err := c.PostDummyLoginWithBody(ctx, contentType, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "lon", c.Request.URL.Query(), &params.Lon)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lon: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostDummyLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostDummyLoginWithResponse(ctx context.Context, body PostDummyLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostDummyLoginResponse, error) {
-	rsp, err := c.PostDummyLogin(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", c.Request.URL.Query(), &params.Radius)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter radius: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostDummyLoginResponse(rsp)
-}
 
-// PostLoginWithBodyWithResponse request with arbitrary body returning *PostLoginResponse
-func (c *ClientWithResponses) PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
-	rsp, err := c.PostLoginWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
-	rsp, err := c.PostLogin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParsePostLoginResponse(rsp)
+
+	siw.Handler.GetPvzNearby(c, params)
 }
 
-// PostProductsWithBodyWithResponse request with arbitrary body returning *PostProductsResponse
-func (c *ClientWithResponses) PostProductsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProductsResponse, error) {
-	rsp, err := c.PostProductsWithBody(ctx, contentType, body, reqEditors...)
+// DeletePvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostProductsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostProductsWithResponse(ctx context.Context, body PostProductsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProductsResponse, error) {
-	rsp, err := c.PostProducts(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParsePostProductsResponse(rsp)
+
+	siw.Handler.DeletePvzPvzId(c, pvzId)
 }
 
-// GetPvzWithResponse request returning *GetPvzResponse
-func (c *ClientWithResponses) GetPvzWithResponse(ctx context.Context, params *GetPvzParams, reqEditors ...RequestEditorFn) (*GetPvzResponse, error) {
-	rsp, err := c.GetPvz(ctx, params, reqEditors...)
+// PatchPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) PatchPvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseGetPvzResponse(rsp)
-}
 
-// PostPvzWithBodyWithResponse request with arbitrary body returning *PostPvzResponse
-func (c *ClientWithResponses) PostPvzWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPvzResponse, error) {
-	rsp, err := c.PostPvzWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParsePostPvzResponse(rsp)
+
+	siw.Handler.PatchPvzPvzId(c, pvzId)
 }
 
-func (c *ClientWithResponses) PostPvzWithResponse(ctx context.Context, body PostPvzJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPvzResponse, error) {
-	rsp, err := c.PostPvz(ctx, body, reqEditors...)
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostPvzResponse(rsp)
-}
 
-// PostPvzPvzIdCloseLastReceptionWithResponse request returning *PostPvzPvzIdCloseLastReceptionResponse
-func (c *ClientWithResponses) PostPvzPvzIdCloseLastReceptionWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdCloseLastReceptionResponse, error) {
-	rsp, err := c.PostPvzPvzIdCloseLastReception(ctx, pvzId, reqEditors...)
-	if err != nil {
-		return nil, err
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParsePostPvzPvzIdCloseLastReceptionResponse(rsp)
+
+	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
 }
 
-// PostPvzPvzIdDeleteLastProductWithResponse request returning *PostPvzPvzIdDeleteLastProductResponse
-func (c *ClientWithResponses) PostPvzPvzIdDeleteLastProductWithResponse(ctx context.Context, pvzId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostPvzPvzIdDeleteLastProductResponse, error) {
-	rsp, err := c.PostPvzPvzIdDeleteLastProduct(ctx, pvzId, reqEditors...)
+// PostPvzPvzIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeactivate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostPvzPvzIdDeleteLastProductResponse(rsp)
-}
 
-// PostReceptionsWithBodyWithResponse request with arbitrary body returning *PostReceptionsResponse
-func (c *ClientWithResponses) PostReceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error) {
-	rsp, err := c.PostReceptionsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParsePostReceptionsResponse(rsp)
+
+	siw.Handler.PostPvzPvzIdDeactivate(c, pvzId)
 }
 
-func (c *ClientWithResponses) PostReceptionsWithResponse(ctx context.Context, body PostReceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostReceptionsResponse, error) {
-	rsp, err := c.PostReceptions(ctx, body, reqEditors...)
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostReceptionsResponse(rsp)
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
 }
 
-// PostRegisterWithBodyWithResponse request with arbitrary body returning *PostRegisterResponse
-func (c *ClientWithResponses) PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
-	rsp, err := c.PostRegisterWithBody(ctx, contentType, body, reqEditors...)
+// GetPvzPvzIdReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdReceptions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostRegisterResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
-	rsp, err := c.PostRegister(ctx, body, reqEditors...)
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdReceptionsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostRegisterResponse(rsp)
-}
 
-// ParsePostDummyLoginResponse parses an HTTP response from a PostDummyLoginWithResponse call
-func ParsePostDummyLoginResponse(rsp *http.Response) (*PostDummyLoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &PostDummyLoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "to" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Token
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// ParsePostLoginResponse parses an HTTP response from a PostLoginWithResponse call
-func ParsePostLoginResponse(rsp *http.Response) (*PostLoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &PostLoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Token
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	siw.Handler.GetPvzPvzIdReceptions(c, pvzId, params)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// GetPvzPvzIdSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdSchedule(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
 
-// ParsePostProductsResponse parses an HTTP response from a PostProductsWithResponse call
-func ParsePostProductsResponse(rsp *http.Response) (*PostProductsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &PostProductsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Product
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON201 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.GetPvzPvzIdSchedule(c, pvzId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// PutPvzPvzIdSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdSchedule(c *gin.Context) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
 
-// ParseGetPvzResponse parses an HTTP response from a GetPvzWithResponse call
-func ParseGetPvzResponse(rsp *http.Response) (*GetPvzResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetPvzResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []struct {
-			Pvz        *PVZ `json:"pvz,omitempty"`
-			Receptions *[]struct {
-				Products  *[]Product `json:"products,omitempty"`
-				Reception *Reception `json:"reception,omitempty"`
-			} `json:"receptions,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.PutPvzPvzIdSchedule(c, pvzId)
 }
 
-// ParsePostPvzResponse parses an HTTP response from a PostPvzWithResponse call
-func ParsePostPvzResponse(rsp *http.Response) (*PostPvzResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeletePvzPvzIdScheduleExceptionsDate operation middleware
+func (siw *ServerInterfaceWrapper) DeletePvzPvzIdScheduleExceptionsDate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &PostPvzResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest PVZ
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "date", c.Param("date"), &date, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	c.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON403 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.DeletePvzPvzIdScheduleExceptionsDate(c, pvzId, date)
 }
 
-// ParsePostPvzPvzIdCloseLastReceptionResponse parses an HTTP response from a PostPvzPvzIdCloseLastReceptionWithResponse call
-func ParsePostPvzPvzIdCloseLastReceptionResponse(rsp *http.Response) (*PostPvzPvzIdCloseLastReceptionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PutPvzPvzIdScheduleExceptionsDate operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdScheduleExceptionsDate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &PostPvzPvzIdCloseLastReceptionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", c.Param("date"), &date, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Reception
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	c.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON400 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	siw.Handler.PutPvzPvzIdScheduleExceptionsDate(c, pvzId, date)
+}
+
+// GetPvzPvzIdStaff operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdStaff(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
 
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.GetPvzPvzIdStaff(c, pvzId)
 }
 
-// ParsePostPvzPvzIdDeleteLastProductResponse parses an HTTP response from a PostPvzPvzIdDeleteLastProductWithResponse call
-func ParsePostPvzPvzIdDeleteLastProductResponse(rsp *http.Response) (*PostPvzPvzIdDeleteLastProductResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// PostPvzPvzIdStaff operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdStaff(c *gin.Context) {
 
-	response := &PostPvzPvzIdDeleteLastProductResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	c.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON403 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.PostPvzPvzIdStaff(c, pvzId)
 }
 
-// ParsePostReceptionsResponse parses an HTTP response from a PostReceptionsWithResponse call
-func ParsePostReceptionsResponse(rsp *http.Response) (*PostReceptionsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeletePvzPvzIdStaffUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePvzPvzIdStaffUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &PostReceptionsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Reception
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	c.Set(BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON403 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.DeletePvzPvzIdStaffUserId(c, pvzId, userId)
 }
 
-// ParsePostRegisterResponse parses an HTTP response from a PostRegisterWithResponse call
-func ParsePostRegisterResponse(rsp *http.Response) (*PostRegisterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
 
-	response := &PostRegisterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	c.Set(ApiKeyAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON400 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.PostReceptions(c)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получение тестового токена
-	// (POST /dummyLogin)
-	PostDummyLogin(c *gin.Context)
-	// Авторизация пользователя
-	// (POST /login)
-	PostLogin(c *gin.Context)
-	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
-	// (POST /products)
-	PostProducts(c *gin.Context)
-	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
-	// (GET /pvz)
-	GetPvz(c *gin.Context, params GetPvzParams)
-	// Создание ПВЗ (только для модераторов)
-	// (POST /pvz)
-	PostPvz(c *gin.Context)
-	// Закрытие последней открытой приемки товаров в рамках ПВЗ
-	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId openapi_types.UUID)
-	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/delete_last_product)
-	PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId openapi_types.UUID)
-	// Создание новой приемки товаров (только для сотрудников ПВЗ)
-	// (POST /receptions)
-	PostReceptions(c *gin.Context)
-	// Регистрация пользователя
-	// (POST /register)
-	PostRegister(c *gin.Context)
-}
+// GetReceptionsReceptionId operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionId(c *gin.Context) {
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
-}
+	var err error
 
-type MiddlewareFunc func(c *gin.Context)
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceptionsReceptionIdParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1693,11 +9848,11 @@ func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostDummyLogin(c)
+	siw.Handler.GetReceptionsReceptionId(c, receptionId, params)
 }
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1706,13 +9861,11 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.PostRegister(c)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
+// PostTokenRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostTokenRefresh(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1721,32 +9874,32 @@ func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProducts(c)
+	siw.Handler.PostTokenRefresh(c)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
+	var params GetUsersParams
 
-	// ------------- Optional query parameter "startDate" -------------
+	// ------------- Optional query parameter "role" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	// ------------- Optional query parameter "disabled" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	err = runtime.BindQueryParameter("form", true, false, "disabled", c.Request.URL.Query(), &params.Disabled)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter disabled: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1773,35 +9926,20 @@ func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetPvz(c, params)
-}
-
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.PostPvz(c)
+	siw.Handler.GetUsers(c, params)
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+// DeleteUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersUserId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1814,20 +9952,20 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+	siw.Handler.DeleteUsersUserId(c, userId)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+// GetUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1840,26 +9978,24 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+	siw.Handler.GetUsersUserId(c, userId)
 }
 
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+// PatchUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersUserId(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-	siw.Handler.PostReceptions(c)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1868,7 +10004,7 @@ func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostRegister(c)
+	siw.Handler.PatchUsersUserId(c, userId)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -1898,48 +10034,183 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/api-keys", wrapper.GetApiKeys)
+	router.POST(options.BaseURL+"/api-keys", wrapper.PostApiKeys)
+	router.DELETE(options.BaseURL+"/api-keys/:keyId", wrapper.DeleteApiKeysKeyId)
+	router.GET(options.BaseURL+"/cities", wrapper.GetCities)
+	router.POST(options.BaseURL+"/cities", wrapper.PostCities)
+	router.DELETE(options.BaseURL+"/cities/:cityId", wrapper.DeleteCitiesCityId)
+	router.PATCH(options.BaseURL+"/cities/:cityId", wrapper.PatchCitiesCityId)
 	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	router.POST(options.BaseURL+"/email/verify", wrapper.PostEmailVerify)
+	router.POST(options.BaseURL+"/email/verify/resend", wrapper.PostEmailVerifyResend)
+	router.GET(options.BaseURL+"/invites", wrapper.GetInvites)
+	router.POST(options.BaseURL+"/invites", wrapper.PostInvites)
+	router.DELETE(options.BaseURL+"/invites/:inviteId", wrapper.DeleteInvitesInviteId)
 	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
+	router.POST(options.BaseURL+"/login/2fa", wrapper.PostLogin2fa)
+	router.POST(options.BaseURL+"/login/2fa/enroll", wrapper.PostLogin2faEnroll)
+	router.POST(options.BaseURL+"/logout", wrapper.PostLogout)
+	router.POST(options.BaseURL+"/me/2fa/confirm", wrapper.PostMe2faConfirm)
+	router.POST(options.BaseURL+"/me/2fa/disable", wrapper.PostMe2faDisable)
+	router.POST(options.BaseURL+"/me/2fa/enroll", wrapper.PostMe2faEnroll)
+	router.POST(options.BaseURL+"/me/password", wrapper.PostMePassword)
+	router.POST(options.BaseURL+"/password/reset", wrapper.PostPasswordReset)
+	router.POST(options.BaseURL+"/password/reset/confirm", wrapper.PostPasswordResetConfirm)
+	router.GET(options.BaseURL+"/product-types", wrapper.GetProductTypes)
+	router.POST(options.BaseURL+"/product-types", wrapper.PostProductTypes)
+	router.DELETE(options.BaseURL+"/product-types/:code", wrapper.DeleteProductTypesCode)
+	router.PATCH(options.BaseURL+"/product-types/:code", wrapper.PatchProductTypesCode)
 	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
 	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
 	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
+	router.GET(options.BaseURL+"/pvz/nearby", wrapper.GetPvzNearby)
+	router.DELETE(options.BaseURL+"/pvz/:pvzId", wrapper.DeletePvzPvzId)
+	router.PATCH(options.BaseURL+"/pvz/:pvzId", wrapper.PatchPvzPvzId)
 	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	router.POST(options.BaseURL+"/pvz/:pvzId/deactivate", wrapper.PostPvzPvzIdDeactivate)
 	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	router.GET(options.BaseURL+"/pvz/:pvzId/receptions", wrapper.GetPvzPvzIdReceptions)
+	router.GET(options.BaseURL+"/pvz/:pvzId/schedule", wrapper.GetPvzPvzIdSchedule)
+	router.PUT(options.BaseURL+"/pvz/:pvzId/schedule", wrapper.PutPvzPvzIdSchedule)
+	router.DELETE(options.BaseURL+"/pvz/:pvzId/schedule/exceptions/:date", wrapper.DeletePvzPvzIdScheduleExceptionsDate)
+	router.PUT(options.BaseURL+"/pvz/:pvzId/schedule/exceptions/:date", wrapper.PutPvzPvzIdScheduleExceptionsDate)
+	router.GET(options.BaseURL+"/pvz/:pvzId/staff", wrapper.GetPvzPvzIdStaff)
+	router.POST(options.BaseURL+"/pvz/:pvzId/staff", wrapper.PostPvzPvzIdStaff)
+	router.DELETE(options.BaseURL+"/pvz/:pvzId/staff/:userId", wrapper.DeletePvzPvzIdStaffUserId)
 	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
+	router.GET(options.BaseURL+"/receptions/:receptionId", wrapper.GetReceptionsReceptionId)
 	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+	router.POST(options.BaseURL+"/token/refresh", wrapper.PostTokenRefresh)
+	router.GET(options.BaseURL+"/users", wrapper.GetUsers)
+	router.DELETE(options.BaseURL+"/users/:userId", wrapper.DeleteUsersUserId)
+	router.GET(options.BaseURL+"/users/:userId", wrapper.GetUsersUserId)
+	router.PATCH(options.BaseURL+"/users/:userId", wrapper.PatchUsersUserId)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ724TSRJ/lVHffeCkASfHffK3u+M4cUK6iONYCRShwe44A54/9LSza5Cl2N6FRWSX",
-	"1QoJCS1iWV5gcGJinHjyCtVvtKrqGXvGHsdOYmUNn2zPVHdXVf+q6lflx6zkOb7nclcGrPiYBaVN7lj0",
-	"9V9CeAK/+MLzuZA2p8cODwKrwvGrrPucFVkghe1WWKNhMsEf1mzBy6x4Zyi4biaC3r37vCRZw2Rrt25P",
-	"7lyyZR0/uVtzcAP4BSLVhD50IGQmg3cQwgD6qnUR3kJXtaCrtuGDaqtt2MX3ryGEfZRRO6lDE+1MZpdx",
-	"9w1POJZkRVar2WWWIyZ4xQ6ksKTtuVcsyTOLypbkF6Xt8MmVY+aTNbm2C69cK8lJ+3Hvm7j1nAeewKIS",
-	"99Gca/PJ6weji1A/wAF00fNqGyIYQA/6+koi2IMufIS95OcH1YZOrv/H3ENvs6rlOetG8v4c3eVvPZrT",
-	"UYG0ZC1Iu8p27/rCqwgeBMxkpaoX8Nm+GFqSnD3cOc8lN70H3M0JP5P9P+A5Acsdy65mzNFPzoAnr5rB",
-	"B3f8qlfnqL/jlbmwpCdmW51oQbtNGoru5aWasGX9f5iUtDH3uCW4+HtNbo5+XU30/c9XN9F1JM2K8duR",
-	"AZtS+qyBG9vuhkcQ4kFJ2DG+MMFgRulATzUN2IMD9cJQbThS2xBCh0JgAD31woC38DO8MqBn0MsedOEQ",
-	"+hDBJ0O1IMJ8RYHSwbNtWSVlrNID7paNgIstu4Su2uIi0AevXlq5tIKO9XzuWr7NiuwyPTKZb8lNMrxQ",
-	"rjlO/bpXsXUoeAFlELxoKwlttuYF8spITvubB/IfXpkya8lzJXdpoeX7VbtESwv3Ax1fOvlPImgx9z3t",
-	"njNiUtQ4PQh8zw308X9dWTmR8n8WfIMV2Z8Ko9JWiOtaQQcPHTp2+e9VE46gq76HAYR4ySF08Dbpgvch",
-	"VE/w7vGW/rZAfXSRzdPnDXShQ4AcqOfwyaDShnCLVFNHR81xLFFH2bcQwYFqq6caotA1qDo2YzRGsAuR",
-	"hmafJELaoFCdjabFAukEqci3guBrT5Rn84xki+GKLwNjq+eOsa6hIaRa8U8s6zDQP8Yh91Oe5gYcERJ3",
-	"YD9Ogy3oYh7VePM18wmOh9xaIrUo1M1fz8+R+GilTgfVxUEj4aJ54PgtqWSIgwg+jIrgciRBA3pwgDV4",
-	"gJDFWOqrFvSgAwMqxZna3NM6Xz4HnV+icqqFzGGkb1c9055L0RpWvJMlNHfWG+uZIHuZ9XuS2ROGERrQ",
-	"oUwPfdVWz1Rb/ZixWrWNCySOEdmHaEhqmhAhpFUb9mJQR9CJac1f4ljdeoQuqPCcKP03l2tbjyjlCsvh",
-	"kouAbJm4vFA9hZBOj/PdHqWEEL/00DPUsUVUkZCVsSJ7WOOizkzmWo4OIktI6sLM1MXM145NKPSajuqq",
-	"p6dWh7vlRSnzBiI4RGgbhJZtSrU99UQ9n3K2j810+uAy37BqVcmKqyZzbNd2MGmtDs+2XckrXEz1xAH0",
-	"KNsjS+ggP9DJ7hCRpkGGoRWOqQfdKepVbceWU/RbMZljfaMVvLwyQ9v1M5ZmW3InyK0CM7PhrduZdjk4",
-	"brtULRuKzJVqhyZbQlj1zIGz9hh1w41GTmeY3XcOicnk9Q6OsP1BphjngxOmrBwq2oz37GOk6dZJNQ31",
-	"LeZvtaPBhfwBupS1dZ4i6jCWw3XHBSHsQo9COF5EfHE6n6BUdVoqMRMv51ywkyPH7i1xK0Swr2nbsnQq",
-	"n2HZfTfyIiE49m5uLYVDzQUJxJoQR9AZFdHCY2J6jQLNgu5WrUDezcT7scBdw7X/xJXXrUCOwn+i9FJG",
-	"9i25maoX8SgpC87cypXPh8+ciedNZTlwToV9qK+zr7bVc6zWS8Y+jzKqqjZ8RMTkaPyZBcGrlAUUBEc0",
-	"jUeKgKSRcnWkWkOZSco9Ngwjsoo8gjylvkvXl0yklHmVyzhU/NSwfGagXKGFGClJsf1D42RqP0W8O1ym",
-	"Xsqcs4sa67nGL3g4Mx2aN5pnfGbwf5+2IQ/+u7oGZBu0QXrWNmzSerCfatNimpNx64Xr167+1zRO26xl",
-	"Gev0QLkxkjvv4crYFGQ5xh8nKUJpbrV0RYi6OLVDcZmtPTSUSxvyZTCyQTzYnlVzLpw+pCp2IOP/044J",
-	"qFhquSbki/6LbniUeZZ/cRYXt/RHZ34blDd+3lnKxig7T/+VSkovGbbMnqc3Gr8HAAD//82HIsO8IQAA",
+	"H4sIAAAAAAAC/+x93W4cx5Xwqwz6+y6yQDOkZC8SE9gLRZIX2mRlriI5QQzBac0UyY5mpifdPbQoggCH",
+	"jCIZdETLq4WNYO1YMRbYy+HPmMOfGb5C1SvkSRZ1qqq7qrv6b9icGUq8scWe6uqqU+f/nDpnzag6jZbT",
+	"RE3fM+bXDK+6jBoW/PPGwp1folX6r5brtJDr2wieV11k+ah2w6d/LDpuw/KNeaNm+WjGtxvIMA1/tYWM",
+	"ecPzXbu5ZKyb4pVfrCqvtNt2TTcaPWnZLvKKfMCu5Zq5bnn+A6/Y2ptWA9HRsR9ayG3Ynmc7TQCL7aOG",
+	"px3IH1iua63Ciy5atJ/QoTXkVV275dtO05g38Le4S57jLj7Bwwo+xifkJf3TrJDnZBMP8S7ZruAh2cQn",
+	"uE9/IJvki3Bcv4L3KqSDz3CfdPAx7uk201p5eqem+fLf8Ff4a5NORucfkg2yjU8reIj3yQbu4gF8sIcH",
+	"wecMMxvaLlpxHhcBNrzzx7btopox/4kBswL4A5ipUJcRy5Tw8mEws/PoD6jq08XctP1ycDknqtnejapv",
+	"r8io88hx6shqMkRsN6vLtywfnRsRU4AWrCEPcG7CiDiI5I3U0KLVrvvGvO+2kTnWfcGopLU/aNUy117G",
+	"Ytd13+eQTeCWVsvmz/+/ixaNeeP/zYYcd5az21n+9rppPEarGvr8Gg+AN/QoKeKexB0q+ACfkJ0KPsRd",
+	"vI+HlHngPXyMu5XfztxYuDND582iNL5I9vWHyZu801yxtXAOnqdtkr9Ngeg8Rk3NNv+Oh/iY8ZkzsoH7",
+	"eB+f4C55wfZNdsRmyQbu4X3K6MgmZVDkz7iP+2YF7+EhPsR78Oxz3MU9skk69AXKP0/IF5TBUbZ2gPt4",
+	"QKfp4sNsPsTWLZatg89t13XcOFwayPOspRzYLQbq5k4CepVztCRpe4ECumHZda0UGYKo6poBVgYCBQ/x",
+	"UQXvkW18AAJlqD1ikFrB99l3xqohFJZZpuE6db2C0C6oabS9nCegY/mwClUghlDK4v93keU+Wl34+Hdx",
+	"LKvZnm81q0hz3N/jLulQEsRDsiMY0wE9WVAnqD6CT4EEKTk+k0+25rQf1SUgNNuNR8jlGkoWH6HrjMKA",
+	"vmaGi9VtUrs9q1ZzkedpdvclPqBshnT4drRkw0kwrsdRLoS7Aij7nAIOKL/u40PQ04D77FGSgWGUY89W",
+	"bVjYedSOj1qoedf5TH9eeBfoERhjheqR4qxIB/fwEWiVHRO0TNIhW/DfTbxHttgLnKi7+Jhs4j7eo3RM",
+	"Nxec+C7u4cMK0xrJn/iuyIb4MNmmmIqs2kfN+mqaFhGhGoEtDeuJ3Wg3jPkP5kyjYTfZHzMfzAWzhIhU",
+	"d5oZk1z7uTLLtZ/rpnHRku35rkWBWEyv8XzLbwNmoSb9wCeGJbQxr+21ULOG6BFW646HasbDRMAkUD3g",
+	"XgKe/7q6jGrtukZo1KxV1VpJIzQxzS1mt0TtGPSkigC5dOTzDekESkogvZkS04f/71RIh6HdPlDGgOyQ",
+	"F3jA/q6wB4ZZbKG3xYq0ZpewfYpxV/aaySCnbDoD+ElKqTiCGNPo4QPcAy1lQLbxkUJGJhWXW4zZ4qPQ",
+	"zhvi4wrZApl6QnYYlUqvGWYJJx0BCKw/ae++tbio4bKeZy81i0nDQMnIVgfyniyIWPc8SMDfN4O1SDtL",
+	"AEkSGpQjeTQujunhnYU5YA5za8F1au2qryMrH923G+gCVEJO8TlxjD2InepfKZergNw8o0JxE+y0LtnI",
+	"0glabMczdF4vE1PhV3XRD5PBeJ+vNWJZODVUlmVRs71W3Vq9m+RFW3StJbuuA9j/kA2yhc/wMe7jIwle",
+	"hk5lSLf0nRXkevZTpHN9/ZV/hqkyVFGhZ9Inm5wLp344KpMp4NQ9hzuUl1HANyOdVJKLJvG8CgGfu3YW",
+	"rbqHskCc6QiKQDx97mwoZgAmUdTm3/+5UEqzodhy7wmKHCPryi8Y46zabn7acp0lkFGcR+tZtKob8J2Y",
+	"gcjkMz9MA8kt5Ft23dNgNoiGQp6MuhOY0XH3BGhXh5ygN5m2ZTLf2TE4vffIC2A33DnRw6f4mGzl8XYr",
+	"LpTcX6bW2Hm/LKNP5MNfgafslOxIX6IiiOwo38F9w8wJ37yYx4jzptPmgSWrVrPpqqz6gnLC/E276aMl",
+	"pjVo5CYLePSYMYqHsvAc4r3IVir4DA8DQYtPDQ3mjZ0wYPIISZgyuciOGxV4qZTzG9tf5oxQQz4t6Zdc",
+	"dgCfSmc+uTL/SpsjRtVRWIQzmeEKdbuUrRI9axBoj55YjRZl5Mb1a/Nzc7qDdFqoGR8+90HC8M8Qelyz",
+	"dMT8mtqzNPI2EBYbdT1fq/xj4zWgHh7Ilhwz3n7GfqWaXYdSIcVlNg3uyWr1zySl+poZI44IIMUapc2Z",
+	"ElzSQBpayumA1TKbGDfWe6JuMr1eLz6l08gWKYY0Xa5dJSkE6XuTl5ytD8lbiKDId3iX7ODDkNNT7dKs",
+	"wKlLTjcasP0LuE/Bt8bQimyobrps928aZO6LSEtss/DLgmVrwhZWtYo8L/lVFy26yFtOGhBZnzxb5F3t",
+	"ij9zPrSqvuPeXLbqddRc0su1MKhAXtDIF40tPGMuVi7Q93CPbMCPQRSIypANcFHO1p0luzl7fdEyzMju",
+	"q+K7yQBATdep1xuo6d8LNqpxt4LQr4D/u4d3uRP1+oc3zAoLgFBW0aVY8Tl5RR/1mIeMBbWGFB/oZkgH",
+	"D4LEgC6LoTBFghopPWkrs2xdWgOpcMgkqpSrUFGjCxp4pB7t7WB8HPkcv2W1/eUHrh2H6YN7dwInNJP5",
+	"NNr5o3AwzuAu9VnTP0HJ4Q5ojisULyCJgjzn8SeINnbxIdW+JDT5j3szNG5FQxn4NA4YEIVU/V+96dSQ",
+	"pyX/Azxg32QnRbZZzHaID8g2FwP0mFkkDO/hE7EFM7Ys8jJfDDN//omHqi7yNet+g3tcOG1W7n90f4Ey",
+	"qEeWh967nokefE5TPr4ooHQo8cBDbnJoM7K+/xRhlByZKizuwCiQ+vCp5vlpw2paVJCaZbk2rEf1JAlX",
+	"wH8JP3yMXHvRTpotr6/K0blSQH9gIQzQQ6gUIltAN7vC13ymN1EqNxbuSNkGQOtMDUaNVt1ZReAVdGrI",
+	"tXyHAtZq12z2Lw3UpYVoVGbT8EMGkQjZCOIJoMLOkzAsUR3Q41lEfEsJCcCg8aGSjdHniIb7uRAtHWvE",
+	"AY4O5TxuVMYE2q7tr1LVqSGnqtxo+8ta9yWgAHVVDgAs+2riBQvsk1ecWeFT4FA9GCRk9akiga2WPfMY",
+	"QVDFpp9YRlYNQMYSggxN+gpbIYXTI2S5yBVrZX99KKjj335z3zBZKiOAF34NZ1n2/ZaxToFgNxcdPR8k",
+	"GyBfO0HGyVbgnD0J41lcjeurFuhQ8RrS/9Nv234dFmNVH6NmreIhd8Wu0nMFRxJ8+NpP5346J1RLq2Ub",
+	"88Z78Mg0Wpa/DIcUgm1+zVhibJyitCU81Ma/Iv8GwMkD9PBaTtNjB3x9bs4Ad2HT56LXarXqdhXenf2D",
+	"x6wBZsflNhnD1KVI1Chuyr+RglYKX+nhIzrB+3PvFVpf2rJYRo5uFa9B+m7SE2WK4hmIvM/psSqkYcx/",
+	"sqYg2icP1x/S0EWjYbmrWRvSUcpR5SeqFOe6jI5Y9v4JHBWOpzniBcdTzviPbeT5v3Bqq4XAp/LCEXJq",
+	"LiA1drQYrUgSlT4bFwXqS9RxvR6jj2ul4Z+aFqjDQ8FRAX8OWVqUWYkIFzXVb6T0NiCsuTEQFo1gg9El",
+	"dApBXEPKR/vM8g1CXMJ/EhAjHoQUEdE6poQ30FW8P4ZVcLEiDEJ8xJwDhZlTgFaASCpMtYK8IHtaN0Nx",
+	"NLv2GK3eqa0zcVpHTN1S2dYteM4Z1y/pcJBsrtVAPnI92BKoAlTahYrAYz5SJV5TAnMWt3gYI/T301Qc",
+	"sCxEBte7hnwBFKLoJ0iYWQ4xKBXCze/IJrNvo1hZHAF5ylyKNnRTJNVdvDIEtwyKqkJSeiDVFYtBkoWW",
+	"tiSBkZBHEP1Kmm4hQWw01SILRjySPW6ZDKejOY3AsQD5q3g31POnQ3pOEw/6YAyrCM+DMxtIRv1cRACZ",
+	"J7UgobxWDzaemDsq65ldo26EXKKP0dVN288r+qpi6EXLPgXikE0Ynvi7I/tCKGiUr3Eh/1cyWvYqDOlp",
+	"vJElAxbD+h/Cw4xjvEjXZvpmYdPU8qvLGvlBH08M0S9GXHH/YS5xNTdWcdXHh/SOBR6EOHolrKaIaRQi",
+	"1i+DexVgj0E0iM7TjT4Hl6Ny9OLyDb0UvC2AsUU6UdVvFDFXazcaq7+iQUbwGnGtMfkswJBXv8Kc5zcW",
+	"Fj69fffjf6mhFWFMeL61ZDeXTDZe3P2jvmLcI88lF0e1btmNyu9hLb83zCjTcTz/VrjMsnxiubzxmbk/",
+	"CaGJ8TITFrbV4e8PcJmgR17waxlUR+I40MeHAuWmibeMg6r/zpVN5sVPggpPq5PuuXQZzacaaJvS3Oz+",
+	"VIj9fIJZiGvNrtCg4KpMdnG8vx1ED1dLQ3w/X2pH0oXYPKj9ftoFUsYDDsgmx4Qf8YEAzrjQULqLLBK6",
+	"jngCYl+OCwqnCPzWheDWiQ4H4psBln3Gtky2g7RF/lmyxa4FMEcB+QKf6lBj1kUeatZyY8g9Nry0oEHe",
+	"ILc2ajsa4lzXIM5/icSqsxCDBhy8OjSCDBw2hrxiRKyG98A9zeizJx/BcIr44PVxWCNvKFzJCwhrnlI9",
+	"IbjyKS2HZeHySOlf+B01GbUZkdxZ0BGGYKtc+shncYy7MvDDYgf6Y+2THemrjFjY5f1U/+AdPmQcDkKp",
+	"BkIhF6Hurvzlj5nqt1VikFQ+2jL4XZGEkaBUhT43JKyRMGSiQ9z8JM/ESzyKvM+TyfpwU4krxEXqMgju",
+	"SLZYYhnuch6oA38vwg7Jjvgk55mCwLbACpHkIltcRXt4wGhz1Xa4oAyYkhTy0gPEgiHowoDa05HDxTSp",
+	"WDacLm98+JKyMjXCmlC0ht5zh1RU0sF9xrbglQHLFOJZiuTlCKY5l26za+wfuZzQnCfe4W/kcs/Z4eCL",
+	"9kT/LZknBdFGPJwWfBlbSoAeKlGHEzAEHp/tg12vZHUyxZZnYsQgOmIENwHriyNzPepiisvzct07RUoM",
+	"WJ73mePWsm1iMUXwxlR4fuCix/m8P9zyKmdN8UseWhtcvjnBVJA9+ebHn7hrdEg2GCleG7sY61WYb4ff",
+	"F2dOWDxgf4yPS/1AntPcW3GIwKeY3aRxUYXJWPms5WmwNqkCCZFJ8pwrybDaM7jPCrZEcP8namR+qffc",
+	"6TPedyReBNeDsvkRu0RUkoGRffko4bZ/1n0deO1t4UUTUljhBs/4OM0b8BN3GLoG6J3ojyRfyMSteiRx",
+	"d0p50SXnLV9LFw0DrUw6quAeGb9QxRlv+DjtMliEFYnrfbk40m1xFXBMfCmd/Uyc72huHiZQXHADLjin",
+	"zCt71z+8UcF7AV73ha5CxSv1vCgoQTp82gArLjVDGQPlUvAKo2YvPdj2rXR99oi5j4e6u9j4SBzasIL3",
+	"wiIZKp1T0nPafibB0TGlhZwz71bHCClfbC2IZ5HtiO1HttlRjkt5VqX6cKQE4q/INjuqoHYWFAOGc9yu",
+	"/AQPA/MUHh6z9EFg9+w+uhJyrYB/FgCvPOeGaQMB+606zUXbbaSjw7+j64vWTT6yNO6bT+UbXcPTIEyE",
+	"qY05+qpXvQQrgrXp78tPBSpPC2PMT07K21SJARBLagoLRRe+c68QEL/6moOAbvGRl56AYvqumU+pUNJw",
+	"yfb0EZ6WM0wB2Y3HJaxE9pI8CQxaOv2jO4KzNYNARzYvGii3bQGkKRkWb6uynpJdEED2Ss6MImcgEUqL",
+	"ypKa1sen7AxiNEXVuwBrZZd8Gs4uiHGlCZO266Kmv5AcEjCNJvpsIXfIIDqh+nqZ6XXdoCZKmD5MXsG9",
+	"4z1akQwKcjMTMY2xKfWVJiygFMSBrCNlkyesaAcrwhP9eSDi6UNO7ZF692e89uAmKDW9y2cmvcGnwsIJ",
+	"N74Tt4nSneGC0iDZMMMcFmh7D4a+zXmG2nI5uotqtPAbPqX5Mto8Qym5EJCxArJrG5/wUiIikaiDd3m2",
+	"nXqaVxmJZWYkfh3OoU09TDmGOKnkcxkoJFO26yBdEJnFMr2vBFNpCePlyqQpDOuoZPWDoo4fM6dzeP9B",
+	"kU65E+HVyvgpGb5SqfDxpPlKHyyc68srFw+Z71KuInVBlQFSPpiWWxsDavl3L+O178ecpKmco5bm+/gs",
+	"0svhqoLAFFQQ0BzMxZYS0Df2KJ4Ep/C02TXqksyR0ynT4k3WPiHHnWs2MDmhc7QETj3o39maAjoWMbni",
+	"ArrVKImi0g2Dc1YaoEpQbGp8yiV+GfSSXoNgjDRxoZJvMsUHRpF8V8UILgm3KUTY38TqDJQs67wM81iM",
+	"Ksskzt/8YypaebHlTvq6UtAZJMHyhf1PqfIr2dxgQqv9S4+irXAux1UkUy3YnFdJlTBVzo0hW+RlpPWQ",
+	"nqCpkUrzLUClY4gNrjaoISToeuVpqjW+8jQui+MtdFnHAxYvZa2/uyxVnd5eo74HVlyfObeDhQ+hV+Ze",
+	"JXSf8GRO3IUZXsZuKEaaB1H6jX9CFKn+Yxu5q6G64PmW699iHUo0F6FS2yto2coAyp4U3XHC6lCzVtba",
+	"pCI3oiel7otwPzZNXYrP/IZukRJB0O/S5B6gLdjcCeABDb++lBp1UScu7vEXgpQE5fajaJyQsNTWytNf",
+	"iz5M4XpHbVSZvi0Fxc6FnqTD3Nk0Rko64Td4q4oEHE3aZd5WVjlsvHPvSpoLn+I+22bEVqCP5WkYKKR3",
+	"yXYCEFqSOlsMP7/lxYg2KjzRjCHjn5M/ZS2p3whaCV3L6u60lqv52V9YIJynXAH3hXYXyvJwL2F5dbth",
+	"+wnrm5NaUb03V3y1ZItsgIDYqLBcCnxABQtItKMY/DivpTS9D/sUruHfztxFT/yZm23Xc1wzaL0QhCpZ",
+	"CwGWr9+rUHhzt/kWT+hQ+rkksSqY3Sjm7xjRVRzTP3O1uJd6rnlp0020yZu+2WWsgXTWiIzyF0LmsB4R",
+	"sEMFSYz58yJjctP7oOgBnwQPNBMAuaWwFbHcBUvbz0pmMpNZqkTXxm9n7ju+VZ+BBoQJ5S7o0nhPoyiD",
+	"kqU4PmBp2mSH5meImzUH7EI8vfW+STbSl7NeRtQDMAmiTkxhIB1lBbz+eY/HiIXm1YsrhxWQafu8hz1/",
+	"KSNOAuruhTiJKJcYsxkoPqktWS/Xprjy/5RXV2KUAq3cEJttIst9tJphj91lg7Kssv9l9SvAKIGPPQ8a",
+	"rgoKS9I6LD9f7YgR+tVrNJHXwJb2R1uq0xx1qTm64msW+z1oyX1uKoTrA436FNQYerjPEtbrWjW77ek1",
+	"un+em5sz01ZMB+gVvZQF/zdYYTQV4zQw0Yfp0kC1DkZRTq/Lyum1uSzt9OE4wvyMbLiyVkyj2aWAwj9S",
+	"ryx5wSQjr+88HWyzsNAFpE3eFxesx5DYssEqEDH3Bj4VCg39BS6RSDVyguu4UK6Ip3UPyQ7zCIRsbg3c",
+	"pHlCpStPF3gf5uxwkOjYfOFFb5j4fIfDpIk9b8ZYdj04Bch034UczH7MO3y+qCjfJ6+8rnjxyo1+TgDN",
+	"L0TDnVD4M1XPvYp0XjGMkGGEHRooOkguaubFl9kHtHOWPcMDfifskIVWTvlNo01IJa9wL/R547Vy/Xd1",
+	"AVItoC4+4H3pu+czOLgkngV38qd1y/M/VdxKqZYy8CzoZ/4ry/NDL9OkhHV5VC17zJLqu3EkqahINIUd",
+	"/MKlCmmpWfGlD6F+Le0pKNatutZY5jMfE48lRxJqIebKAhz0jvQzuaeLQjs1BKQvmhZnUsytcPzlp5QM",
+	"2Sth2pXMuwwyr6D8ilFdGcKI2YRMGvGASV7Koi9SYSTCJhMlsMTMm7jtOGmBYebMt4lk50Q5ZtB/WzKk",
+	"yM60UP45JUzMPIxKmH2G62oqz0DuGqImnskXO2OA/smv7nz4kVk5R1pPQE9qZDLFswxEdC8cPR7qMdfG",
+	"lgoRZCqFsem+qKslKwd9spMva2fRdRplphP9uZxl+U5Zi3rHExqmIB8hl51yC/mWXfdG7VURYtNlDdqv",
+	"X/l1LlHz8yxR+w2PHGxoWF6QskA6eBDw8254SbcXl4B0J7V2HeWRf78WY98K4yzYjbYbIqjiUAWMmbq7",
+	"QKLbSnjr8jTRj6eT4P1wh7ibtMdWW2dXtCeKERfinhcbmZibfkRsvPLgX1pOH3cXBEVuROEFkZMA5lMm",
+	"wZ7TtyAkwSx6Isyc2TVKDQWi0QKLbwdT3BqfM8/UzltjC8gxLR85Wuj7G9JRSkdAyp5s7b9zvV50ENF0",
+	"ejlvJLof/QwUQFbJpaeSS+EAdU4p+NZhffmiNgaqyQjc2DJyYzBYdc+48SXR9ZXUvYxSV8uj0pkHk9Cs",
+	"RflW5R8br2lpJ17FWziGg9ZUHaibCu11nkPa1/Z5hbRvLS7mstVg4OUw1PJd9/j4d2xPhQsRpfmEr8jm",
+	"YLTM7ghE+ybbFBSyxWf4hFY9E62kDkfNxTBzRLbGjuhl3N1ve8jNdXk/cpeevzeOW/QXQZQRrNGgjJnS",
+	"/bNTjKinJ9Mkocgmu+y3E+lOq93Z6TvKpxgE4yCZZPJAHI0jyUMhNsusb7T0ATaXqn0qH8dd+SNlaBez",
+	"a4zLFDH76XsP4K2JWj1tsYSLTnTX4EAHD8gODfx33lHV4k0CkZZJFW8YkJNpgXRGpwQ1GSBZ81DSAMZb",
+	"yyeiDkxHTZ0iKaFK2+9pSwktlAz2ViTuxK5oimq2WRmgIxfVCYlsdi34Nxc2STZtSHD3wjdyCRpXGX8u",
+	"cXOVATI9GSC5uNFvbH85KLqWzZlwPwIG+qyHe9FiwheZCBL51lubF3IcguXd7IAfIF0sHtI9N0/XxPoz",
+	"yxSZSvGhxBpUHOckJHvOmj/ssV82yA70xenFkz2DRk0uWrI9H7lZOhYfNYEm+XZzxfbDDrHJ9fEZWPdp",
+	"jo3om0tbM0k9AKQ7S3BZkspGAUqpFV1kioKd+03Ddeq6ciyvcC/xGyaoO8y83oAM3b44WKmzeMLL+JRn",
+	"X0Zawh2EJMYEn1LlSoAFNVp1ZxUhwwzSVoNHD/O1QpHgMWntl5q9ekpP7K0SKl2mxMqfQ0Ahu7mKMGV6",
+	"5FVKj6+pvG2loJEorpzUsXeMAfPv9QTAuOAp9ImMoXMyebyMdo34PpG+kpsVQaeSWd7NNZ1XAqe6x0eO",
+	"r4GvTJXK6Onuzv8th/aO6NbRlXvl8nYRY2mPdS/Wqje15YpZ4Sj0Ch8HdKV0QJ7SJirBYg/UhpNADT28",
+	"G9Zqpy30osTzHd5Vux+y+yVMUVFPjtFkvAMy2WI0Rd2DqRc9HsAAvWEZLVfj1DNLMOre481ja7p3HzlO",
+	"HVnNq/qNRYzdsQSfmZAvfIMgofnjkdaIfCdq6iWCpLw6e5eyUloerBnBpQ0cr0BABxhggUDO2AIuicr0",
+	"O11rKCWufI7sDt1tTm2buqLJHKlid9JYNzcxi/AKb8vBW53jqyTMTStRNSnsLT85l25iMvm4hZ0oVxdf",
+	"3l461nTqESYj7sftyxIJfX19/f8GAJd04vJK5gAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file